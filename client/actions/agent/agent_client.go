@@ -0,0 +1,50 @@
+package agent
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+// AgentService is the interface used to perform the actions Razee agents perform
+// from within a cluster.  These are only available to requests authenticated with
+// an org key, see orgkey.RazeeOrgKeyAuthClient; the organization is implied by the key.
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AgentService
+type AgentService interface {
+	SubscriptionsByClusterID(clusterID string) ([]types.UpdatedSubscription, error)
+}
+
+// Client is an implementation of a satcon client.
+type Client struct {
+	web.SatConClient
+}
+
+// NewClient returns a configured instance of AgentService which can then be used
+// to perform agent queries against Satellite Config.  The authClient should be an
+// orgkey.RazeeOrgKeyAuthClient, e.g.
+//
+//	authClient, err := orgkey.NewClient(orgKey)
+//	...
+//	agentClient, err := agent.NewClient(endpointURL, nil, authClient)
+func NewClient(endpointURL string, httpClient web.HTTPClient, authClient auth.AuthClient) (AgentService, error) {
+	if endpointURL == "" {
+		return nil, errors.New("Must supply a valid endpoint URL")
+	}
+
+	s := web.SatConClient{
+		Endpoint:   endpointURL,
+		HTTPClient: http.DefaultClient,
+		AuthClient: authClient,
+	}
+
+	if httpClient != nil {
+		s.HTTPClient = httpClient
+	}
+
+	return &Client{
+		s,
+	}, nil
+}
@@ -0,0 +1,117 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package agentfakes
+
+import (
+	"sync"
+
+	"github.com/IBM/satcon-client-go/client/actions/agent"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+type FakeAgentService struct {
+	SubscriptionsByClusterIDStub        func(string) ([]types.UpdatedSubscription, error)
+	subscriptionsByClusterIDMutex       sync.RWMutex
+	subscriptionsByClusterIDArgsForCall []struct {
+		arg1 string
+	}
+	subscriptionsByClusterIDReturns struct {
+		result1 []types.UpdatedSubscription
+		result2 error
+	}
+	subscriptionsByClusterIDReturnsOnCall map[int]struct {
+		result1 []types.UpdatedSubscription
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAgentService) SubscriptionsByClusterID(arg1 string) ([]types.UpdatedSubscription, error) {
+	fake.subscriptionsByClusterIDMutex.Lock()
+	ret, specificReturn := fake.subscriptionsByClusterIDReturnsOnCall[len(fake.subscriptionsByClusterIDArgsForCall)]
+	fake.subscriptionsByClusterIDArgsForCall = append(fake.subscriptionsByClusterIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SubscriptionsByClusterIDStub
+	fakeReturns := fake.subscriptionsByClusterIDReturns
+	fake.recordInvocation("SubscriptionsByClusterID", []interface{}{arg1})
+	fake.subscriptionsByClusterIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAgentService) SubscriptionsByClusterIDCallCount() int {
+	fake.subscriptionsByClusterIDMutex.RLock()
+	defer fake.subscriptionsByClusterIDMutex.RUnlock()
+	return len(fake.subscriptionsByClusterIDArgsForCall)
+}
+
+func (fake *FakeAgentService) SubscriptionsByClusterIDCalls(stub func(string) ([]types.UpdatedSubscription, error)) {
+	fake.subscriptionsByClusterIDMutex.Lock()
+	defer fake.subscriptionsByClusterIDMutex.Unlock()
+	fake.SubscriptionsByClusterIDStub = stub
+}
+
+func (fake *FakeAgentService) SubscriptionsByClusterIDArgsForCall(i int) string {
+	fake.subscriptionsByClusterIDMutex.RLock()
+	defer fake.subscriptionsByClusterIDMutex.RUnlock()
+	argsForCall := fake.subscriptionsByClusterIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAgentService) SubscriptionsByClusterIDReturns(result1 []types.UpdatedSubscription, result2 error) {
+	fake.subscriptionsByClusterIDMutex.Lock()
+	defer fake.subscriptionsByClusterIDMutex.Unlock()
+	fake.SubscriptionsByClusterIDStub = nil
+	fake.subscriptionsByClusterIDReturns = struct {
+		result1 []types.UpdatedSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAgentService) SubscriptionsByClusterIDReturnsOnCall(i int, result1 []types.UpdatedSubscription, result2 error) {
+	fake.subscriptionsByClusterIDMutex.Lock()
+	defer fake.subscriptionsByClusterIDMutex.Unlock()
+	fake.SubscriptionsByClusterIDStub = nil
+	if fake.subscriptionsByClusterIDReturnsOnCall == nil {
+		fake.subscriptionsByClusterIDReturnsOnCall = make(map[int]struct {
+			result1 []types.UpdatedSubscription
+			result2 error
+		})
+	}
+	fake.subscriptionsByClusterIDReturnsOnCall[i] = struct {
+		result1 []types.UpdatedSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAgentService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.subscriptionsByClusterIDMutex.RLock()
+	defer fake.subscriptionsByClusterIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAgentService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ agent.AgentService = new(FakeAgentService)
@@ -0,0 +1,50 @@
+package agent
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	//QuerySubscriptionsByClusterID specifies the query
+	QuerySubscriptionsByClusterID = "subscriptionsByClusterId"
+	//SubscriptionsByClusterIDVarTemplate is the template used to create the graphql query
+	SubscriptionsByClusterIDVarTemplate = `{{define "vars"}}"clusterId":{{json .ClusterID}}{{end}}`
+)
+
+//SubscriptionsByClusterIDVariables are the variables used for the subscriptions query
+type SubscriptionsByClusterIDVariables struct {
+	actions.GraphQLQuery
+	ClusterID string
+}
+
+//NewSubscriptionsByClusterIDVariables generates variables used for query
+func NewSubscriptionsByClusterIDVariables(clusterID string) SubscriptionsByClusterIDVariables {
+	vars := SubscriptionsByClusterIDVariables{
+		ClusterID: clusterID,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QuerySubscriptionsByClusterID
+	vars.Args = map[string]string{
+		"clusterId": "String!",
+	}
+	vars.Returns = []string{
+		"subscriptionName",
+		"subscriptionChannel",
+		"subscriptionVersion",
+		"subscriptionUuid",
+		"url",
+		"kubeOwnerName",
+	}
+
+	return vars
+}
+
+//SubscriptionsByClusterID returns the subscriptions the cluster's Razee agent should apply
+func (c *Client) SubscriptionsByClusterID(clusterID string) ([]types.UpdatedSubscription, error) {
+	vars := NewSubscriptionsByClusterIDVariables(clusterID)
+
+	return web.Do[[]types.UpdatedSubscription](&c.SatConClient, QuerySubscriptionsByClusterID, SubscriptionsByClusterIDVarTemplate, vars, nil)
+}
@@ -0,0 +1,130 @@
+package agent_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/agent"
+	"github.com/IBM/satcon-client-go/client/auth/orgkey"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("SubscriptionsByClusterID", func() {
+
+	var (
+		clusterID string
+	)
+
+	BeforeEach(func() {
+		clusterID = "some-cluster"
+	})
+
+	Describe("NewSubscriptionsByClusterIDVariables", func() {
+		It("Returns a correctly populated instance of SubscriptionsByClusterIDVariables", func() {
+			vars := NewSubscriptionsByClusterIDVariables(clusterID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QuerySubscriptionsByClusterID))
+			Expect(vars.ClusterID).To(Equal(clusterID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"clusterId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"subscriptionName",
+				"subscriptionChannel",
+				"subscriptionVersion",
+				"subscriptionUuid",
+				"url",
+				"kubeOwnerName",
+			))
+		})
+	})
+
+	Describe("SubscriptionsByClusterID", func() {
+
+		var (
+			subscriptionsResponse []types.UpdatedSubscription
+			c                     AgentService
+			httpClient            *webfakes.FakeHTTPClient
+			response              *http.Response
+		)
+
+		BeforeEach(func() {
+			subscriptionsResponse = []types.UpdatedSubscription{
+				{
+					SubscriptionName:    "sub1",
+					SubscriptionChannel: "channel1",
+					SubscriptionVersion: "v1",
+					SubscriptionUUID:    "sub1-uuid",
+					URL:                 "api/v1/channels/channel1/version1-uuid",
+				},
+				{
+					SubscriptionName:    "sub2",
+					SubscriptionChannel: "channel2",
+					SubscriptionVersion: "v2",
+					SubscriptionUUID:    "sub2-uuid",
+					URL:                 "api/v1/channels/channel2/version2-uuid",
+					KubeOwnerName:       "owner",
+				},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QuerySubscriptionsByClusterID: subscriptionsResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			httpClient = &webfakes.FakeHTTPClient{}
+			Expect(httpClient.DoCallCount()).To(Equal(0))
+			httpClient.DoReturns(response, nil)
+
+			orgKeyClient, err := orgkey.NewClient("some_org_key")
+			Expect(err).NotTo(HaveOccurred())
+			c, _ = NewClient("https://foo.bar", httpClient, orgKeyClient)
+		})
+
+		It("Makes a valid http request authenticated with the org key", func() {
+			_, err := c.SubscriptionsByClusterID(clusterID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpClient.DoCallCount()).To(Equal(1))
+			Expect(httpClient.DoArgsForCall(0).Header.Get(orgkey.OrgKeyHeader)).To(Equal("some_org_key"))
+		})
+
+		It("Returns the list of subscriptions", func() {
+			subscriptions, _ := c.SubscriptionsByClusterID(clusterID)
+			Expect(subscriptions).To(Equal(subscriptionsResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				httpClient.DoReturns(response, errors.New("None whatsoever, Frank."))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.SubscriptionsByClusterID(clusterID)
+				Expect(err).To(MatchError(MatchRegexp("None whatsoever, Frank.")))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				subscriptions, err := c.SubscriptionsByClusterID(clusterID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(subscriptions).To(BeNil())
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package channels
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -38,34 +39,12 @@ func NewAddChannelVariables(orgID, name string) AddChannelVariables {
 	return vars
 }
 
-// AddChannelResponse is the response body we get upon a successful cluster
-// registration.
-type AddChannelResponse struct {
-	Data *AddChannelResponseData `json:"data,omitempty"`
-}
-
-type AddChannelResponseData struct {
-	Details *AddChannelResponseDataDetails `json:"addChannel,omitempty"`
-}
-
 type AddChannelResponseDataDetails struct {
 	UUID string `json:"uuid,omitempty"`
 }
 
 func (c *Client) AddChannel(orgID, name string) (*AddChannelResponseDataDetails, error) {
-	var response AddChannelResponse
-
 	vars := NewAddChannelVariables(orgID, name)
 
-	err := c.DoQuery(AddChannelVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*AddChannelResponseDataDetails](&c.SatConClient, QueryAddChannel, AddChannelVarTemplate, vars, nil)
 }
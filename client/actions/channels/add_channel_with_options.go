@@ -0,0 +1,93 @@
+package channels
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	// ContentTypeUpload channels have versions whose content is uploaded, as with AddChannel
+	ContentTypeUpload = "upload"
+	// ContentTypeRemote channels have versions which reference content held elsewhere
+	ContentTypeRemote = "remote"
+
+	AddChannelWithOptionsVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"name":{{json .Name}},"contentType":{{json .ContentType}},"remote":{{json .Remote}},"tags":{{json .Tags}},"custom":{{json .Custom}}{{end}}`
+)
+
+// AddChannelOptions are the optional settings of a new channel
+type AddChannelOptions struct {
+	// ContentType is ContentTypeUpload, the default, or ContentTypeRemote
+	ContentType string
+	// Remote locates the content of a remote channel's versions.  Remote channels
+	// must supply one with its type, e.g. "github"; upload channels must not.
+	Remote *types.ChannelRemote
+	types.Attributes
+}
+
+// AddChannelWithOptionsVariables are the variables specific to adding a channel
+// with options.  These include the organization ID, the channel name and its
+// options.  Rather than instantiating this directly, use NewAddChannelWithOptionsVariables().
+type AddChannelWithOptionsVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	Name  string
+	AddChannelOptions
+}
+
+// NewAddChannelWithOptionsVariables creates a correctly formed instance of AddChannelWithOptionsVariables.
+func NewAddChannelWithOptionsVariables(orgID, name string, opts AddChannelOptions) AddChannelWithOptionsVariables {
+	if opts.ContentType == "" {
+		opts.ContentType = ContentTypeUpload
+	}
+
+	vars := AddChannelWithOptionsVariables{
+		OrgID:             orgID,
+		Name:              name,
+		AddChannelOptions: opts,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryAddChannel
+	vars.Args = map[string]string{
+		"orgId":       "String!",
+		"name":        "String!",
+		"contentType": "String",
+		"remote":      "ChannelRemoteInput",
+		"tags":        "[String!]",
+		"custom":      "JSON",
+	}
+	vars.Returns = []string{
+		"uuid",
+	}
+
+	return vars
+}
+
+// AddChannelWithOptions adds a channel with the specified content type, remote,
+// tags and custom fields.
+func (c *Client) AddChannelWithOptions(orgID, name string, opts AddChannelOptions) (*AddChannelResponseDataDetails, error) {
+	vars := NewAddChannelWithOptionsVariables(orgID, name, opts)
+
+	switch vars.ContentType {
+	case ContentTypeRemote:
+		if vars.Remote == nil || vars.Remote.RemoteType == "" {
+			return nil, errors.New("Must supply a remote type for a remote channel")
+		}
+	case ContentTypeUpload:
+		if vars.Remote != nil {
+			return nil, errors.New("Only remote channels may have a remote")
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported content type %s, must be %s or %s", vars.ContentType, ContentTypeUpload, ContentTypeRemote)
+	}
+
+	if err := vars.Attributes.Validate(); err != nil {
+		return nil, err
+	}
+
+	return web.Do[*AddChannelResponseDataDetails](&c.SatConClient, QueryAddChannel, AddChannelWithOptionsVarTemplate, vars, nil)
+}
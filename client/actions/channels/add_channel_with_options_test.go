@@ -0,0 +1,205 @@
+package channels_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/channels"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Adding a Channel with options", func() {
+	var (
+		orgID, name    string
+		remote         *types.ChannelRemote
+		opts           AddChannelOptions
+		c              ChannelService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		name = "somechannel"
+		remote = &types.ChannelRemote{
+			RemoteType: "github",
+			Parameters: []types.ParameterTuple{
+				{Key: "repo", Value: "https://github.com/someorg/config.git"},
+			},
+		}
+		opts = AddChannelOptions{
+			ContentType: ContentTypeRemote,
+			Remote:      remote,
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewAddChannelWithOptionsVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewAddChannelWithOptionsVariables(orgID, name, opts)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryAddChannel))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Name).To(Equal(name))
+			Expect(vars.ContentType).To(Equal(ContentTypeRemote))
+			Expect(vars.Remote).To(Equal(remote))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":       "String!",
+				"name":        "String!",
+				"contentType": "String",
+				"remote":      "ChannelRemoteInput",
+				"tags":        "[String!]",
+				"custom":      "JSON",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+			))
+		})
+
+		It("Defaults to an upload channel", func() {
+			vars := NewAddChannelWithOptionsVariables(orgID, name, AddChannelOptions{})
+			Expect(vars.ContentType).To(Equal(ContentTypeUpload))
+			Expect(vars.Remote).To(BeNil())
+		})
+	})
+
+	Describe("AddChannelWithOptions", func() {
+		var (
+			agResponse *AddChannelResponseDataDetails
+		)
+
+		BeforeEach(func() {
+			agResponse = &AddChannelResponseDataDetails{
+				UUID: "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddChannel: agResponse}})
+			Expect(err).NotTo(HaveOccurred())
+
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the content type and remote", func() {
+			_, err := c.AddChannelWithOptions(orgID, name, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":       orgID,
+				"name":        name,
+				"contentType": "remote",
+				"remote": map[string]interface{}{
+					"remoteType": "github",
+					"parameters": []interface{}{
+						map[string]interface{}{"key": "repo", "value": "https://github.com/someorg/config.git"},
+					},
+				},
+				"tags":   nil,
+				"custom": nil,
+			}))
+		})
+
+		It("Sends the tags and custom fields", func() {
+			opts.Tags = []string{"prod"}
+			opts.Custom = map[string]interface{}{"owner": "edge@example.com"}
+			_, err := c.AddChannelWithOptions(orgID, name, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("tags", []interface{}{"prod"}))
+			Expect(body.Variables).To(HaveKeyWithValue("custom", map[string]interface{}{"owner": "edge@example.com"}))
+		})
+
+		It("Sends a null remote for upload channels", func() {
+			_, err := c.AddChannelWithOptions(orgID, name, AddChannelOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("contentType", "upload"))
+			Expect(body.Variables).To(HaveKeyWithValue("remote", BeNil()))
+		})
+
+		It("Returns the add channel details", func() {
+			details, _ := c.AddChannelWithOptions(orgID, name, opts)
+			Expect(details).To(Equal(agResponse))
+		})
+
+		It("Requires a remote type for remote channels", func() {
+			_, err := c.AddChannelWithOptions(orgID, name, AddChannelOptions{ContentType: ContentTypeRemote, Remote: &types.ChannelRemote{}})
+			Expect(err).To(MatchError("Must supply a remote type for a remote channel"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Rejects a remote for upload channels", func() {
+			_, err := c.AddChannelWithOptions(orgID, name, AddChannelOptions{ContentType: ContentTypeUpload, Remote: remote})
+			Expect(err).To(MatchError("Only remote channels may have a remote"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Rejects unknown content types", func() {
+			_, err := c.AddChannelWithOptions(orgID, name, AddChannelOptions{ContentType: "carrier-pigeon"})
+			Expect(err).To(MatchError("Unsupported content type carrier-pigeon, must be upload or remote"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Rejects invalid attributes without sending a request", func() {
+			opts.Tags = []string{""}
+			_, err := c.AddChannelWithOptions(orgID, name, opts)
+			Expect(err).To(MatchError("Tags must not be empty"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.AddChannelWithOptions(orgID, name, opts)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.AddChannelWithOptions(orgID, name, opts)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
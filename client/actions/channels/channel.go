@@ -3,6 +3,7 @@ package channels
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -34,39 +35,21 @@ func NewChannelVariables(orgID, uuid string) ChannelVariables {
 		"uuid",
 		"orgId",
 		"name",
+		"tags",
+		"custom",
+		"contentType",
+		"remote{remoteType, parameters{key, value}}",
 		"created",
-		"versions{uuid, name, location}",
+		"versions{uuid, name, location, remote{parameters{key, value}}}",
 		"subscriptions{uuid, orgId, name, groups}",
 	}
 
 	return vars
 }
 
-// ChannelResponse channel data
-type ChannelResponse struct {
-	Data *ChannelResponseData `json:"data,omitempty"`
-}
-
-// ChannelResponseData channel details
-type ChannelResponseData struct {
-	Channel *types.Channel `json:"channel,omitempty"`
-}
-
 // Channel returns channel specified by channeUuid
 func (c *Client) Channel(orgID, uuid string) (*types.Channel, error) {
-	var response ChannelResponse
-
 	vars := NewChannelVariables(orgID, uuid)
 
-	err := c.DoQuery(ChannelVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Channel, err
-	}
-
-	return nil, err
+	return web.Do[*types.Channel](&c.SatConClient, QueryChannel, ChannelVarTemplate, vars, nil)
 }
@@ -3,6 +3,7 @@ package channels
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -34,39 +35,21 @@ func NewChannelByNameVariables(orgID, channelName string) ChannelByNameVariables
 		"uuid",
 		"orgId",
 		"name",
+		"tags",
+		"custom",
+		"contentType",
+		"remote{remoteType, parameters{key, value}}",
 		"created",
-		"versions{uuid, name, description, location, created}",
+		"versions{uuid, name, description, location, remote{parameters{key, value}}, created}",
 		"subscriptions{uuid, orgId, name, groups, channelUuid, channelName, version, versionUuid, created, updated}",
 	}
 
 	return vars
 }
 
-// ChannelVersionByNameResponse top level response struct
-type ChannelByNameResponse struct {
-	Data *ChannelByNameResponseData `json:"data,omitempty"`
-}
-
-// ChannelVersionByNameResponseData provides response details
-type ChannelByNameResponseData struct {
-	Details *types.Channel `json:"channelByName,omitempty"`
-}
-
 // ChannelVersionByName queries a channel version given orgID, channelName, and versionName
 func (c *Client) ChannelByName(orgID, channelName string) (*types.Channel, error) {
-	var response ChannelByNameResponse
-
 	vars := NewChannelByNameVariables(orgID, channelName)
 
-	err := c.DoQuery(ChannelByNameVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, nil
-	}
-
-	return nil, err
+	return web.Do[*types.Channel](&c.SatConClient, QueryChannelByName, ChannelByNameVarTemplate, vars, nil)
 }
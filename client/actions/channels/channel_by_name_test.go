@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -14,6 +15,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -41,8 +43,12 @@ var _ = Describe("ChannelByName", func() {
 				"uuid",
 				"orgId",
 				"name",
+				"tags",
+				"custom",
+				"contentType",
+				"remote{remoteType, parameters{key, value}}",
 				"created",
-				"versions{uuid, name, description, location, created}",
+				"versions{uuid, name, description, location, remote{parameters{key, value}}, created}",
 				"subscriptions{uuid, orgId, name, groups, channelUuid, channelName, version, versionUuid, created, updated}",
 			))
 		})
@@ -53,51 +59,47 @@ var _ = Describe("ChannelByName", func() {
 			c               ChannelService
 			h               *webfakes.FakeHTTPClient
 			response        *http.Response
-			channelResponse ChannelByNameResponse
+			channelResponse *types.Channel
 		)
 
 		BeforeEach(func() {
-			channelResponse = ChannelByNameResponse{
-				Data: &ChannelByNameResponseData{
-					Details: &types.Channel{
-						UUID:    "asdf",
-						OrgID:   orgID,
-						Name:    "channel1",
-						Created: "whenever",
-						Versions: types.ChannelVersionList{
-							{
-								Name:        "version1",
-								UUID:        "vesion-uuid-1",
-								Location:    "location1",
-								Description: "desc1",
-								Created:     "then1",
-							},
-							{
-								Name:        "version2",
-								UUID:        "vesion-uuid-2",
-								Location:    "location2",
-								Description: "desc2",
-								Created:     "then2",
-							},
-						},
-						Subscriptions: []types.ChannelSubscription{
-							{
-								Groups:      []string{"group-1", "group-2"},
-								Name:        "this-subscription",
-								UUID:        "subscription-uuid-1",
-								OrgID:       "userOrg",
-								ChannelUUID: "subscription-channel-uuid-1",
-								ChannelName: "subscription-channel-name",
-								Version:     "someversion",
-								Created:     "then",
-								Updated:     "now",
-							},
-						},
+			channelResponse = &types.Channel{
+				UUID:    "asdf",
+				OrgID:   types.OrgID(orgID),
+				Name:    "channel1",
+				Created: types.Timestamp{Time: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)},
+				Versions: types.ChannelVersionList{
+					{
+						Name:        "version1",
+						UUID:        "vesion-uuid-1",
+						Location:    "location1",
+						Description: "desc1",
+						Created:     types.Timestamp{Time: time.Date(2021, time.March, 2, 0, 0, 0, 0, time.UTC)},
+					},
+					{
+						Name:        "version2",
+						UUID:        "vesion-uuid-2",
+						Location:    "location2",
+						Description: "desc2",
+						Created:     types.Timestamp{Time: time.Date(2021, time.March, 3, 0, 0, 0, 0, time.UTC)},
+					},
+				},
+				Subscriptions: []types.ChannelSubscription{
+					{
+						Groups:      []string{"group-1", "group-2"},
+						Name:        "this-subscription",
+						UUID:        "subscription-uuid-1",
+						OrgID:       "userOrg",
+						ChannelUUID: "subscription-channel-uuid-1",
+						ChannelName: "subscription-channel-name",
+						Version:     "someversion",
+						Created:     types.Timestamp{Time: time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)},
+						Updated:     types.Timestamp{Time: time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC)},
 					},
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(channelResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryChannelByName: channelResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -118,7 +120,7 @@ var _ = Describe("ChannelByName", func() {
 
 		It("Returns the specified channel", func() {
 			channel, _ := c.ChannelByName(orgID, channelName)
-			expected := channelResponse.Data.Details
+			expected := channelResponse
 			Expect(channel).To(Equal(expected))
 		})
 
@@ -135,13 +137,13 @@ var _ = Describe("ChannelByName", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ChannelByNameResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				channel, err := c.ChannelByName(orgID, channelName)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(channel).To(BeNil())
 			})
 		})
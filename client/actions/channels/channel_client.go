@@ -18,6 +18,9 @@ type ChannelService interface {
 	ChannelByName(orgID, channelName string) (*types.Channel, error)
 	Channels(orgId string) (types.ChannelList, error)
 	RemoveChannel(orgId, uuid string) (*RemoveChannelResponseDataDetails, error)
+	EditChannel(orgID, uuid string, opts EditChannelOptions) (*types.Channel, error)
+	AddChannelWithOptions(orgID, name string, opts AddChannelOptions) (*AddChannelResponseDataDetails, error)
+	ChannelsByTags(orgID string, tags []string) (types.ChannelList, error)
 }
 
 // Client is an implementation of a satcon client.
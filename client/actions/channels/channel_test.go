@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -14,6 +15,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -44,8 +46,12 @@ var _ = Describe("Channels", func() {
 				"uuid",
 				"orgId",
 				"name",
+				"tags",
+				"custom",
+				"contentType",
+				"remote{remoteType, parameters{key, value}}",
 				"created",
-				"versions{uuid, name, location}",
+				"versions{uuid, name, location, remote{parameters{key, value}}}",
 				"subscriptions{uuid, orgId, name, groups}",
 			))
 		})
@@ -56,38 +62,39 @@ var _ = Describe("Channels", func() {
 			c               ChannelService
 			h               *webfakes.FakeHTTPClient
 			response        *http.Response
-			channelResponse ChannelResponse
+			channelResponse *types.Channel
 		)
 
 		BeforeEach(func() {
-			channelResponse = ChannelResponse{
-				Data: &ChannelResponseData{
-					Channel: &types.Channel{
-						UUID:    "asdf",
-						OrgID:   orgID,
-						Name:    "channel1",
-						Created: "Now",
-						Versions: types.ChannelVersionList{
-							{
-								UUID:        "version1uuid",
-								Name:        "version1",
-								Description: "version1 description",
-								Location:    "kitchen",
-								Created:     "yesterday",
-							},
-							{
-								UUID:        "version2uuid",
-								Name:        "version2",
-								Description: "version2 description",
-								Location:    "library",
-								Created:     "day-before-yesterday",
-							},
-						},
+			channelResponse = &types.Channel{
+				UUID:        "asdf",
+				OrgID:       types.OrgID(orgID),
+				Name:        "channel1",
+				Created:     types.Timestamp{Time: time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC)},
+				ContentType: ContentTypeRemote,
+				Remote: &types.ChannelRemote{
+					RemoteType: "github",
+					Parameters: []types.ParameterTuple{{Key: "repo", Value: "https://github.com/someorg/config.git"}},
+				},
+				Versions: types.ChannelVersionList{
+					{
+						UUID:        "version1uuid",
+						Name:        "version1",
+						Description: "version1 description",
+						Location:    "kitchen",
+						Created:     types.Timestamp{Time: time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)},
+					},
+					{
+						UUID:        "version2uuid",
+						Name:        "version2",
+						Description: "version2 description",
+						Location:    "library",
+						Created:     types.Timestamp{Time: time.Date(2021, time.March, 3, 0, 0, 0, 0, time.UTC)},
 					},
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(channelResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryChannel: channelResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -108,7 +115,7 @@ var _ = Describe("Channels", func() {
 
 		It("Returns the specified channel", func() {
 			groups, _ := c.Channel(orgID, uuid)
-			expected := channelResponse.Data.Channel
+			expected := channelResponse
 			Expect(groups).To(Equal(expected))
 		})
 
@@ -125,13 +132,13 @@ var _ = Describe("Channels", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ChannelsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				groups, err := c.Channel(orgID, uuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(groups).To(BeNil())
 			})
 		})
@@ -3,6 +3,7 @@ package channels
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -29,34 +30,18 @@ func NewChannelsVariables(orgID string) ChannelsVariables {
 		"uuid",
 		"orgId",
 		"name",
+		"tags",
+		"custom",
+		"contentType",
+		"remote{remoteType, parameters{key, value}}",
 		"created",
 	}
 
 	return vars
 }
 
-type ChannelsResponse struct {
-	Data *ChannelsResponseData `json:"data,omitempty"`
-}
-
-type ChannelsResponseData struct {
-	Channels types.ChannelList `json:"channels,omitempty"`
-}
-
 func (c *Client) Channels(orgID string) (types.ChannelList, error) {
-	var response ChannelsResponse
-
 	vars := NewChannelsVariables(orgID)
 
-	err := c.DoQuery(ChannelsVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Channels, err
-	}
-
-	return nil, err
+	return web.Do[types.ChannelList](&c.SatConClient, QueryChannels, ChannelsVarTemplate, vars, nil)
 }
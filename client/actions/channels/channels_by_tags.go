@@ -0,0 +1,23 @@
+package channels
+
+import (
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// ChannelsByTags returns the channels of the organization which have every one of tags.
+// The filtering is done client side, after listing all of the organization's channels.
+func (c *Client) ChannelsByTags(orgID string, tags []string) (types.ChannelList, error) {
+	list, err := c.Channels(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagged types.ChannelList
+	for _, item := range list {
+		if item.HasTags(tags...) {
+			tagged = append(tagged, item)
+		}
+	}
+
+	return tagged, nil
+}
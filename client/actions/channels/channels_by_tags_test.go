@@ -0,0 +1,77 @@
+package channels_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/channels"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("ChannelsByTags", func() {
+	var (
+		orgID          string
+		c              ChannelService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		list           types.ChannelList
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		list = types.ChannelList{
+			{UUID: "uuid1", Name: "channel1", Attributes: types.Attributes{Tags: []string{"prod", "team-edge"}}},
+			{UUID: "uuid2", Name: "channel2", Attributes: types.Attributes{Tags: []string{"dev", "team-edge"}}},
+			{UUID: "uuid3", Name: "channel3"},
+		}
+
+		respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryChannels: list}})
+		Expect(err).NotTo(HaveOccurred())
+		response = &http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		h.DoReturns(response, nil)
+
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+	})
+
+	It("Returns the channels which have every tag", func() {
+		result, err := c.ChannelsByTags(orgID, []string{"team-edge", "prod"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(types.ChannelList{list[0]}))
+	})
+
+	It("Returns every channel when no tags are given", func() {
+		result, err := c.ChannelsByTags(orgID, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(list))
+	})
+
+	It("Returns nothing when no channel matches", func() {
+		result, err := c.ChannelsByTags(orgID, []string{"staging"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+
+	Context("When query execution errors", func() {
+		BeforeEach(func() {
+			h.DoReturns(response, errors.New("Kablooie!"))
+		})
+
+		It("Bubbles up the error", func() {
+			_, err := c.ChannelsByTags(orgID, []string{"prod"})
+			Expect(err).To(MatchError("Kablooie!"))
+		})
+	})
+})
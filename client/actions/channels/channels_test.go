@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -39,6 +40,10 @@ var _ = Describe("Channels", func() {
 				"uuid",
 				"orgId",
 				"name",
+				"tags",
+				"custom",
+				"contentType",
+				"remote{remoteType, parameters{key, value}}",
 				"created",
 			))
 		})
@@ -49,34 +54,30 @@ var _ = Describe("Channels", func() {
 			c              ChannelService
 			h              *webfakes.FakeHTTPClient
 			response       *http.Response
-			groupsResponse ChannelsResponse
+			groupsResponse types.ChannelList
 			fakeAuthClient authfakes.FakeAuthClient
 		)
 
 		BeforeEach(func() {
-			groupsResponse = ChannelsResponse{
-				Data: &ChannelsResponseData{
-					Channels: types.ChannelList{
-						{
-							UUID:  "asdf",
-							OrgID: orgID,
-							Name:  "cluster1",
-						},
-						{
-							UUID:  "qwer",
-							OrgID: orgID,
-							Name:  "cluster2",
-						},
-						{
-							UUID:  "xzcv",
-							OrgID: orgID,
-							Name:  "cluster3",
-						},
-					},
+			groupsResponse = types.ChannelList{
+				{
+					UUID:  "asdf",
+					OrgID: types.OrgID(orgID),
+					Name:  "cluster1",
+				},
+				{
+					UUID:  "qwer",
+					OrgID: types.OrgID(orgID),
+					Name:  "cluster2",
+				},
+				{
+					UUID:  "xzcv",
+					OrgID: types.OrgID(orgID),
+					Name:  "cluster3",
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(groupsResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryChannels: groupsResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -97,7 +98,7 @@ var _ = Describe("Channels", func() {
 
 		It("Returns the list of channels", func() {
 			groups, _ := c.Channels(orgID)
-			expected := groupsResponse.Data.Channels
+			expected := groupsResponse
 			Expect(groups).To(Equal(expected))
 		})
 
@@ -114,13 +115,13 @@ var _ = Describe("Channels", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ChannelsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				groups, err := c.Channels(orgID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(groups).To(BeNil())
 			})
 		})
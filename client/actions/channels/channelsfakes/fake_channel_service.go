@@ -23,6 +23,21 @@ type FakeChannelService struct {
 		result1 *channels.AddChannelResponseDataDetails
 		result2 error
 	}
+	AddChannelWithOptionsStub        func(string, string, channels.AddChannelOptions) (*channels.AddChannelResponseDataDetails, error)
+	addChannelWithOptionsMutex       sync.RWMutex
+	addChannelWithOptionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 channels.AddChannelOptions
+	}
+	addChannelWithOptionsReturns struct {
+		result1 *channels.AddChannelResponseDataDetails
+		result2 error
+	}
+	addChannelWithOptionsReturnsOnCall map[int]struct {
+		result1 *channels.AddChannelResponseDataDetails
+		result2 error
+	}
 	ChannelStub        func(string, string) (*types.Channel, error)
 	channelMutex       sync.RWMutex
 	channelArgsForCall []struct {
@@ -64,6 +79,35 @@ type FakeChannelService struct {
 		result1 types.ChannelList
 		result2 error
 	}
+	ChannelsByTagsStub        func(string, []string) (types.ChannelList, error)
+	channelsByTagsMutex       sync.RWMutex
+	channelsByTagsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	channelsByTagsReturns struct {
+		result1 types.ChannelList
+		result2 error
+	}
+	channelsByTagsReturnsOnCall map[int]struct {
+		result1 types.ChannelList
+		result2 error
+	}
+	EditChannelStub        func(string, string, channels.EditChannelOptions) (*types.Channel, error)
+	editChannelMutex       sync.RWMutex
+	editChannelArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 channels.EditChannelOptions
+	}
+	editChannelReturns struct {
+		result1 *types.Channel
+		result2 error
+	}
+	editChannelReturnsOnCall map[int]struct {
+		result1 *types.Channel
+		result2 error
+	}
 	RemoveChannelStub        func(string, string) (*channels.RemoveChannelResponseDataDetails, error)
 	removeChannelMutex       sync.RWMutex
 	removeChannelArgsForCall []struct {
@@ -147,6 +191,72 @@ func (fake *FakeChannelService) AddChannelReturnsOnCall(i int, result1 *channels
 	}{result1, result2}
 }
 
+func (fake *FakeChannelService) AddChannelWithOptions(arg1 string, arg2 string, arg3 channels.AddChannelOptions) (*channels.AddChannelResponseDataDetails, error) {
+	fake.addChannelWithOptionsMutex.Lock()
+	ret, specificReturn := fake.addChannelWithOptionsReturnsOnCall[len(fake.addChannelWithOptionsArgsForCall)]
+	fake.addChannelWithOptionsArgsForCall = append(fake.addChannelWithOptionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 channels.AddChannelOptions
+	}{arg1, arg2, arg3})
+	stub := fake.AddChannelWithOptionsStub
+	fakeReturns := fake.addChannelWithOptionsReturns
+	fake.recordInvocation("AddChannelWithOptions", []interface{}{arg1, arg2, arg3})
+	fake.addChannelWithOptionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeChannelService) AddChannelWithOptionsCallCount() int {
+	fake.addChannelWithOptionsMutex.RLock()
+	defer fake.addChannelWithOptionsMutex.RUnlock()
+	return len(fake.addChannelWithOptionsArgsForCall)
+}
+
+func (fake *FakeChannelService) AddChannelWithOptionsCalls(stub func(string, string, channels.AddChannelOptions) (*channels.AddChannelResponseDataDetails, error)) {
+	fake.addChannelWithOptionsMutex.Lock()
+	defer fake.addChannelWithOptionsMutex.Unlock()
+	fake.AddChannelWithOptionsStub = stub
+}
+
+func (fake *FakeChannelService) AddChannelWithOptionsArgsForCall(i int) (string, string, channels.AddChannelOptions) {
+	fake.addChannelWithOptionsMutex.RLock()
+	defer fake.addChannelWithOptionsMutex.RUnlock()
+	argsForCall := fake.addChannelWithOptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeChannelService) AddChannelWithOptionsReturns(result1 *channels.AddChannelResponseDataDetails, result2 error) {
+	fake.addChannelWithOptionsMutex.Lock()
+	defer fake.addChannelWithOptionsMutex.Unlock()
+	fake.AddChannelWithOptionsStub = nil
+	fake.addChannelWithOptionsReturns = struct {
+		result1 *channels.AddChannelResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeChannelService) AddChannelWithOptionsReturnsOnCall(i int, result1 *channels.AddChannelResponseDataDetails, result2 error) {
+	fake.addChannelWithOptionsMutex.Lock()
+	defer fake.addChannelWithOptionsMutex.Unlock()
+	fake.AddChannelWithOptionsStub = nil
+	if fake.addChannelWithOptionsReturnsOnCall == nil {
+		fake.addChannelWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 *channels.AddChannelResponseDataDetails
+			result2 error
+		})
+	}
+	fake.addChannelWithOptionsReturnsOnCall[i] = struct {
+		result1 *channels.AddChannelResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeChannelService) Channel(arg1 string, arg2 string) (*types.Channel, error) {
 	fake.channelMutex.Lock()
 	ret, specificReturn := fake.channelReturnsOnCall[len(fake.channelArgsForCall)]
@@ -341,6 +451,142 @@ func (fake *FakeChannelService) ChannelsReturnsOnCall(i int, result1 types.Chann
 	}{result1, result2}
 }
 
+func (fake *FakeChannelService) ChannelsByTags(arg1 string, arg2 []string) (types.ChannelList, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.channelsByTagsMutex.Lock()
+	ret, specificReturn := fake.channelsByTagsReturnsOnCall[len(fake.channelsByTagsArgsForCall)]
+	fake.channelsByTagsArgsForCall = append(fake.channelsByTagsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.ChannelsByTagsStub
+	fakeReturns := fake.channelsByTagsReturns
+	fake.recordInvocation("ChannelsByTags", []interface{}{arg1, arg2Copy})
+	fake.channelsByTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeChannelService) ChannelsByTagsCallCount() int {
+	fake.channelsByTagsMutex.RLock()
+	defer fake.channelsByTagsMutex.RUnlock()
+	return len(fake.channelsByTagsArgsForCall)
+}
+
+func (fake *FakeChannelService) ChannelsByTagsCalls(stub func(string, []string) (types.ChannelList, error)) {
+	fake.channelsByTagsMutex.Lock()
+	defer fake.channelsByTagsMutex.Unlock()
+	fake.ChannelsByTagsStub = stub
+}
+
+func (fake *FakeChannelService) ChannelsByTagsArgsForCall(i int) (string, []string) {
+	fake.channelsByTagsMutex.RLock()
+	defer fake.channelsByTagsMutex.RUnlock()
+	argsForCall := fake.channelsByTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeChannelService) ChannelsByTagsReturns(result1 types.ChannelList, result2 error) {
+	fake.channelsByTagsMutex.Lock()
+	defer fake.channelsByTagsMutex.Unlock()
+	fake.ChannelsByTagsStub = nil
+	fake.channelsByTagsReturns = struct {
+		result1 types.ChannelList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeChannelService) ChannelsByTagsReturnsOnCall(i int, result1 types.ChannelList, result2 error) {
+	fake.channelsByTagsMutex.Lock()
+	defer fake.channelsByTagsMutex.Unlock()
+	fake.ChannelsByTagsStub = nil
+	if fake.channelsByTagsReturnsOnCall == nil {
+		fake.channelsByTagsReturnsOnCall = make(map[int]struct {
+			result1 types.ChannelList
+			result2 error
+		})
+	}
+	fake.channelsByTagsReturnsOnCall[i] = struct {
+		result1 types.ChannelList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeChannelService) EditChannel(arg1 string, arg2 string, arg3 channels.EditChannelOptions) (*types.Channel, error) {
+	fake.editChannelMutex.Lock()
+	ret, specificReturn := fake.editChannelReturnsOnCall[len(fake.editChannelArgsForCall)]
+	fake.editChannelArgsForCall = append(fake.editChannelArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 channels.EditChannelOptions
+	}{arg1, arg2, arg3})
+	stub := fake.EditChannelStub
+	fakeReturns := fake.editChannelReturns
+	fake.recordInvocation("EditChannel", []interface{}{arg1, arg2, arg3})
+	fake.editChannelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeChannelService) EditChannelCallCount() int {
+	fake.editChannelMutex.RLock()
+	defer fake.editChannelMutex.RUnlock()
+	return len(fake.editChannelArgsForCall)
+}
+
+func (fake *FakeChannelService) EditChannelCalls(stub func(string, string, channels.EditChannelOptions) (*types.Channel, error)) {
+	fake.editChannelMutex.Lock()
+	defer fake.editChannelMutex.Unlock()
+	fake.EditChannelStub = stub
+}
+
+func (fake *FakeChannelService) EditChannelArgsForCall(i int) (string, string, channels.EditChannelOptions) {
+	fake.editChannelMutex.RLock()
+	defer fake.editChannelMutex.RUnlock()
+	argsForCall := fake.editChannelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeChannelService) EditChannelReturns(result1 *types.Channel, result2 error) {
+	fake.editChannelMutex.Lock()
+	defer fake.editChannelMutex.Unlock()
+	fake.EditChannelStub = nil
+	fake.editChannelReturns = struct {
+		result1 *types.Channel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeChannelService) EditChannelReturnsOnCall(i int, result1 *types.Channel, result2 error) {
+	fake.editChannelMutex.Lock()
+	defer fake.editChannelMutex.Unlock()
+	fake.EditChannelStub = nil
+	if fake.editChannelReturnsOnCall == nil {
+		fake.editChannelReturnsOnCall = make(map[int]struct {
+			result1 *types.Channel
+			result2 error
+		})
+	}
+	fake.editChannelReturnsOnCall[i] = struct {
+		result1 *types.Channel
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeChannelService) RemoveChannel(arg1 string, arg2 string) (*channels.RemoveChannelResponseDataDetails, error) {
 	fake.removeChannelMutex.Lock()
 	ret, specificReturn := fake.removeChannelReturnsOnCall[len(fake.removeChannelArgsForCall)]
@@ -411,12 +657,18 @@ func (fake *FakeChannelService) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addChannelMutex.RLock()
 	defer fake.addChannelMutex.RUnlock()
+	fake.addChannelWithOptionsMutex.RLock()
+	defer fake.addChannelWithOptionsMutex.RUnlock()
 	fake.channelMutex.RLock()
 	defer fake.channelMutex.RUnlock()
 	fake.channelByNameMutex.RLock()
 	defer fake.channelByNameMutex.RUnlock()
 	fake.channelsMutex.RLock()
 	defer fake.channelsMutex.RUnlock()
+	fake.channelsByTagsMutex.RLock()
+	defer fake.channelsByTagsMutex.RUnlock()
+	fake.editChannelMutex.RLock()
+	defer fake.editChannelMutex.RUnlock()
 	fake.removeChannelMutex.RLock()
 	defer fake.removeChannelMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,98 @@
+package channels
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEditChannel       = "editChannel"
+	EditChannelVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}},"name":{{json .Name}}{{with .Attributes}},"tags":{{json .Tags}},"custom":{{json .Custom}}{{end}}{{end}}`
+)
+
+// EditChannelOptions are the changes EditChannel makes to a channel
+type EditChannelOptions struct {
+	// Name is the channel's name, which is always set, so pass the current name to keep it
+	Name string
+	// Attributes, if set, replace the channel's tags and custom fields, and empty
+	// attributes clear them.  If nil, the tags and custom fields are not sent.
+	Attributes *types.Attributes
+}
+
+// EditChannelVariables are the variables specific to editing a channel.
+// These include the organization ID, the channel UUID and the changes.  Rather
+// than instantiating this directly, use NewEditChannelVariables().
+type EditChannelVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	UUID  string
+	EditChannelOptions
+}
+
+// NewEditChannelVariables creates a correctly formed instance of EditChannelVariables.
+func NewEditChannelVariables(orgID, uuid string, opts EditChannelOptions) EditChannelVariables {
+	if opts.Attributes != nil {
+		// Empty tags and custom fields are sent as [] and {} to clear them
+		explicit := opts.Attributes.Explicit()
+		opts.Attributes = &explicit
+	}
+
+	vars := EditChannelVariables{
+		OrgID:              orgID,
+		UUID:               uuid,
+		EditChannelOptions: opts,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEditChannel
+	vars.Args = map[string]string{
+		"orgId":  "String!",
+		"uuid":   "String!",
+		"name":   "String!",
+		"tags":   "[String!]",
+		"custom": "JSON",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"name",
+		"success",
+	}
+
+	return vars
+}
+
+type EditChannelResponseDataDetails struct {
+	UUID    string `json:"uuid,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// EditChannel edits the channel in place and returns the updated channel.
+// The channel keeps its UUID, versions and subscriptions, unlike removing it
+// and adding it again under a new name.
+func (c *Client) EditChannel(orgID, uuid string, opts EditChannelOptions) (*types.Channel, error) {
+	if opts.Name == "" {
+		return nil, errors.New("Must supply a channel name")
+	}
+	if opts.Attributes != nil {
+		if err := opts.Attributes.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	vars := NewEditChannelVariables(orgID, uuid, opts)
+
+	details, err := web.Do[*EditChannelResponseDataDetails](&c.SatConClient, QueryEditChannel, EditChannelVarTemplate, vars, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !details.Success {
+		return nil, fmt.Errorf("Unable to edit channel %s", uuid)
+	}
+
+	return c.Channel(orgID, uuid)
+}
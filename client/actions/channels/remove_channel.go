@@ -2,6 +2,7 @@ package channels
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -39,35 +40,13 @@ func NewRemoveChannelVariables(orgID, uuid string) RemoveChannelVariables {
 	return vars
 }
 
-// RemoveChannelResponse is the response body we get upon a successful cluster
-// registration.
-type RemoveChannelResponse struct {
-	Data *RemoveChannelResponseData `json:"data,omitempty"`
-}
-
-type RemoveChannelResponseData struct {
-	Details *RemoveChannelResponseDataDetails `json:"removeChannel,omitempty"`
-}
-
 type RemoveChannelResponseDataDetails struct {
 	UUID    string `json:"uuid,omitempty"`
 	Success bool   `json:"success,omitempty"`
 }
 
 func (c *Client) RemoveChannel(orgID, uuid string) (*RemoveChannelResponseDataDetails, error) {
-	var response RemoveChannelResponse
-
 	vars := NewRemoveChannelVariables(orgID, uuid)
 
-	err := c.DoQuery(RemoveChannelVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*RemoveChannelResponseDataDetails](&c.SatConClient, QueryRemoveChannel, RemoveChannelVarTemplate, vars, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -61,20 +62,16 @@ var _ = Describe("Removing a Channel", func() {
 
 	Describe("RemoveChannel", func() {
 		var (
-			rcResponse RemoveChannelResponse
+			rcResponse *RemoveChannelResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			rcResponse = RemoveChannelResponse{
-				Data: &RemoveChannelResponseData{
-					Details: &RemoveChannelResponseDataDetails{
-						UUID:    "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
-						Success: true,
-					},
-				},
+			rcResponse = &RemoveChannelResponseDataDetails{
+				UUID:    "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
+				Success: true,
 			}
 
-			respBodyBytes, err := json.Marshal(rcResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryRemoveChannel: rcResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -90,7 +87,7 @@ var _ = Describe("Removing a Channel", func() {
 			details, _ := c.RemoveChannel(orgID, uuid)
 			Expect(details).NotTo(BeNil())
 
-			expected := rcResponse.Data.Details
+			expected := rcResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -107,13 +104,13 @@ var _ = Describe("Removing a Channel", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(RemoveChannelResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.RemoveChannel(orgID, uuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -0,0 +1,253 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+// DefaultBulkConcurrency is the number of clusters RegisterInventory registers at once
+// unless told otherwise
+const DefaultBulkConcurrency = 4
+
+// BulkRegistrationOptions controls RegisterInventory
+type BulkRegistrationOptions struct {
+	// OutputDir is where the registration URL of each cluster is written, to a
+	// file named after the cluster with a .url extension.  It is required, and
+	// also records progress: clusters which already have a URL file are skipped.
+	OutputDir string
+	// Concurrency is how many clusters are registered at once, DefaultBulkConcurrency if 0
+	Concurrency int
+	// RequestsPerSecond limits how often a cluster registration is started, 0 means no limit
+	RequestsPerSecond float64
+}
+
+// BulkRegistrationResult is the outcome of registering a single inventory cluster
+type BulkRegistrationResult struct {
+	Name      string
+	ClusterID string
+	URL       string
+	// Skipped is set if the cluster's URL file existed, i.e. it was registered by a
+	// previous run.  ClusterID is not known for skipped clusters.
+	Skipped bool
+	// Resumed is set if the cluster had already been registered, but its URL had
+	// not been written, e.g. because a previous run was interrupted.
+	Resumed bool
+	Err     error
+}
+
+// BulkRegistrationReport lists the result for every inventory cluster, in inventory order
+type BulkRegistrationReport struct {
+	Results []BulkRegistrationResult
+}
+
+// Failed returns the results of the clusters which could not be registered
+func (r *BulkRegistrationReport) Failed() []BulkRegistrationResult {
+	var failed []BulkRegistrationResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// RegisterInventory registers the inventory clusters concurrently, adds each to its
+// groups and writes its registration URL to opts.OutputDir.  Every group named in
+// the inventory must exist, which is checked before anything is registered.
+//
+// It is safe to run again after an interruption or failure.  Clusters with a URL
+// file are skipped, and clusters which already exist in SatCon are added to their
+// groups and have their registration URL re-enabled, rather than being registered
+// again.  A failure to register one cluster does not stop the others, so the
+// error returned only covers problems which prevent registering any of them; check
+// the report for the outcome of each cluster.  If ctx is cancelled, clusters not
+// yet started fail with the context's error.
+func RegisterInventory(ctx context.Context, clusterService ClusterService, groupService groups.GroupService, orgID string, inventory []InventoryEntry, opts BulkRegistrationOptions) (*BulkRegistrationReport, error) {
+	if opts.OutputDir == "" {
+		return nil, errors.New("Must supply an output directory")
+	}
+	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := checkInventory(inventory); err != nil {
+		return nil, err
+	}
+
+	groupUUIDs, err := inventoryGroupUUIDs(groupService, orgID, inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	var tick <-chan time.Time
+	if opts.RequestsPerSecond > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.RequestsPerSecond))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	report := &BulkRegistrationReport{Results: make([]BulkRegistrationResult, len(inventory))}
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				entry := inventory[i]
+				report.Results[i] = registerInventoryEntry(clusterService, groupService, orgID, entry, groupUUIDs[i], opts.OutputDir)
+			}
+		}()
+	}
+
+	started := 0
+	for i, entry := range inventory {
+		report.Results[i].Name = entry.Registration.Name
+
+		filename := filepath.Join(opts.OutputDir, urlFilename(entry.Registration.Name))
+		if url, err := os.ReadFile(filename); err == nil {
+			report.Results[i].URL = strings.TrimSpace(string(url))
+			report.Results[i].Skipped = true
+			continue
+		}
+
+		if err = waitToStart(ctx, tick, started); err == nil {
+			select {
+			case work <- i:
+				started++
+				continue
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		report.Results[i].Err = err
+	}
+	close(work)
+	wg.Wait()
+
+	return report, nil
+}
+
+// waitToStart waits until the rate limit allows another registration to start.
+// The first one starts straight away.
+func waitToStart(ctx context.Context, tick <-chan time.Time, started int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if tick == nil || started == 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-tick:
+		return nil
+	}
+}
+
+// inventoryGroupUUIDs resolves the group names of every inventory cluster, failing
+// if any group does not exist.
+func inventoryGroupUUIDs(groupService groups.GroupService, orgID string, inventory []InventoryEntry) ([][]string, error) {
+	groupUUIDs := make([][]string, len(inventory))
+
+	var uuids map[string]string
+	for i, entry := range inventory {
+		if len(entry.Groups) == 0 {
+			continue
+		}
+
+		if uuids == nil {
+			var err error
+			if uuids, err = groupUUIDIndex(groupService, orgID); err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		if groupUUIDs[i], err = lookupGroupUUIDs(uuids, entry.Groups); err != nil {
+			return nil, fmt.Errorf("Cluster %s: %s", entry.Registration.Name, err)
+		}
+	}
+
+	return groupUUIDs, nil
+}
+
+// registerInventoryEntry registers a cluster, or picks up where a previous run left
+// off if it already exists, and then writes its URL file.
+func registerInventoryEntry(clusterService ClusterService, groupService groups.GroupService, orgID string, entry InventoryEntry, groupUUIDs []string, outputDir string) BulkRegistrationResult {
+	result := BulkRegistrationResult{Name: entry.Registration.Name}
+
+	existing, err := clusterService.ClusterByName(orgID, entry.Registration.Name)
+	var notFound *web.ErrNotFound
+	switch {
+	case errors.As(err, &notFound):
+		details, err := clusterService.RegisterCluster(orgID, entry.Registration)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.ClusterID = details.ClusterID
+		result.URL = details.URL
+	case err != nil:
+		result.Err = err
+		return result
+	default:
+		urlDetails, err := clusterService.EnableRegistrationURL(orgID, string(existing.ClusterID))
+		if err != nil {
+			result.Err = fmt.Errorf("Cluster %s already exists, but its registration URL could not be enabled: %s", existing.ClusterID, err)
+			return result
+		}
+		result.ClusterID = string(existing.ClusterID)
+		result.URL = urlDetails.URL
+		result.Resumed = true
+	}
+
+	if err = addToGroups(groupService, orgID, result.ClusterID, groupUUIDs, entry.Groups); err != nil {
+		result.Err = fmt.Errorf("Cluster %s was registered, but %s", result.ClusterID, err)
+		return result
+	}
+
+	if err = writeURLFile(filepath.Join(outputDir, urlFilename(entry.Registration.Name)), result.URL); err != nil {
+		result.Err = fmt.Errorf("Cluster %s was registered, but its URL could not be written: %s", result.ClusterID, err)
+	}
+
+	return result
+}
+
+// writeURLFile writes the URL file in one step, so that an interrupted write never
+// leaves a partial file which would be mistaken for a finished registration.
+func writeURLFile(filename, url string) error {
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, []byte(url+"\n"), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
+
+// urlFilename returns the name of a cluster's URL file, replacing any characters
+// which are not safe in file names.
+func urlFilename(clusterName string) string {
+	safe := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, clusterName)
+	return safe + ".url"
+}
@@ -0,0 +1,227 @@
+package clusters_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/actions/clusters/clustersfakes"
+	"github.com/IBM/satcon-client-go/client/actions/groups/groupsfakes"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("RegisterInventory", func() {
+	var (
+		ctx            context.Context
+		orgID          string
+		outputDir      string
+		inventory      []InventoryEntry
+		opts           BulkRegistrationOptions
+		clusterService *clustersfakes.FakeClusterService
+		groupService   *groupsfakes.FakeGroupService
+	)
+
+	urlFile := func(name string) string {
+		b, err := os.ReadFile(filepath.Join(outputDir, name+".url"))
+		Expect(err).NotTo(HaveOccurred())
+		return string(b)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgID = "someorg"
+		outputDir = filepath.Join(GinkgoT().TempDir(), "urls")
+		opts = BulkRegistrationOptions{OutputDir: outputDir}
+
+		inventory = []InventoryEntry{
+			{Registration: types.Registration{Name: "edge-001"}, Groups: []string{"production", "site-a"}},
+			{Registration: types.Registration{Name: "edge-002"}, Groups: []string{"production"}},
+			{Registration: types.Registration{Name: "edge-003"}},
+		}
+
+		clusterService = &clustersfakes.FakeClusterService{}
+		clusterService.ClusterByNameReturns(nil, &web.ErrNotFound{Field: QueryClusterByName})
+		clusterService.RegisterClusterStub = func(orgID string, reg types.Registration) (*RegisterClusterResponseDataDetails, error) {
+			return &RegisterClusterResponseDataDetails{
+				ClusterID: reg.Name + "-id",
+				URL:       "https://over.there/" + reg.Name,
+			}, nil
+		}
+
+		groupService = &groupsfakes.FakeGroupService{}
+		groupService.GroupsReturns(types.GroupList{
+			{UUID: "group1", Name: "production"},
+			{UUID: "group2", Name: "site-a"},
+		}, nil)
+	})
+
+	It("Registers every cluster, groups it and writes its URL", func() {
+		report, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(report.Results).To(Equal([]BulkRegistrationResult{
+			{Name: "edge-001", ClusterID: "edge-001-id", URL: "https://over.there/edge-001"},
+			{Name: "edge-002", ClusterID: "edge-002-id", URL: "https://over.there/edge-002"},
+			{Name: "edge-003", ClusterID: "edge-003-id", URL: "https://over.there/edge-003"},
+		}))
+
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(3))
+		Expect(groupService.GroupsCallCount()).To(Equal(1))
+		Expect(groupService.GroupClustersCallCount()).To(Equal(3))
+
+		Expect(urlFile("edge-001")).To(Equal("https://over.there/edge-001\n"))
+		Expect(urlFile("edge-003")).To(Equal("https://over.there/edge-003\n"))
+	})
+
+	It("Registers clusters which the server reports as not found", func() {
+		respond := func(body string) *http.Response {
+			return &http.Response{Body: ioutil.NopCloser(bytes.NewBufferString(body))}
+		}
+		h := &webfakes.FakeHTTPClient{}
+		h.DoReturnsOnCall(0, respond(`{"errors":[{"message":"Could not find the cluster with name edge-003.","extensions":{"code":"NOT_FOUND"}}],"data":null}`), nil)
+		h.DoReturnsOnCall(1, respond(`{"data":{"registerCluster":{"url":"https://over.there/edge-003","orgId":"someorg","clusterId":"edge-003-id","regState":"registering"}}}`), nil)
+		client, err := NewClient("https://foo.bar", h, &authfakes.FakeAuthClient{})
+		Expect(err).NotTo(HaveOccurred())
+
+		report, err := RegisterInventory(ctx, client, groupService, orgID, inventory[2:], opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Results).To(Equal([]BulkRegistrationResult{
+			{Name: "edge-003", ClusterID: "edge-003-id", URL: "https://over.there/edge-003"},
+		}))
+		Expect(h.DoCallCount()).To(Equal(2))
+	})
+
+	It("Skips clusters whose URL was written by a previous run", func() {
+		Expect(os.MkdirAll(outputDir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(outputDir, "edge-002.url"), []byte("https://over.there/edge-002\n"), 0o600)).To(Succeed())
+
+		report, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Results[1]).To(Equal(BulkRegistrationResult{Name: "edge-002", URL: "https://over.there/edge-002", Skipped: true}))
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(2))
+		Expect(clusterService.ClusterByNameCallCount()).To(Equal(2))
+	})
+
+	It("Resumes clusters which were registered but whose URL was not written", func() {
+		clusterService.ClusterByNameStub = func(orgID, name string) (*types.Cluster, error) {
+			if name == "edge-001" {
+				return &types.Cluster{ClusterID: "existing-id", Name: name}, nil
+			}
+			return nil, &web.ErrNotFound{Field: QueryClusterByName}
+		}
+		clusterService.EnableRegistrationURLReturns(&EnableRegistrationURLResponseDataDetails{URL: "https://over.there/again"}, nil)
+
+		report, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Results[0]).To(Equal(BulkRegistrationResult{Name: "edge-001", ClusterID: "existing-id", URL: "https://over.there/again", Resumed: true}))
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(2))
+
+		_, clusterID := clusterService.EnableRegistrationURLArgsForCall(0)
+		Expect(clusterID).To(Equal("existing-id"))
+		Expect(urlFile("edge-001")).To(Equal("https://over.there/again\n"))
+	})
+
+	It("Carries on past clusters which fail, without writing their URL", func() {
+		clusterService.RegisterClusterStub = nil
+		clusterService.RegisterClusterReturns(&RegisterClusterResponseDataDetails{ClusterID: "id", URL: "https://over.there"}, nil)
+		clusterService.RegisterClusterReturnsOnCall(0, nil, errors.New("Kablooie!"))
+		opts.Concurrency = 1
+
+		report, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Failed()).To(HaveLen(1))
+		Expect(report.Results[0].Err).To(MatchError("Kablooie!"))
+		Expect(filepath.Join(outputDir, "edge-001.url")).NotTo(BeAnExistingFile())
+		Expect(filepath.Join(outputDir, "edge-002.url")).To(BeAnExistingFile())
+	})
+
+	It("Does not write the URL of a cluster which could not be grouped", func() {
+		groupService.GroupClustersReturns(nil, errors.New("Kablooie!"))
+		opts.Concurrency = 1
+
+		report, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Results[0].Err).To(MatchError(ContainSubstring("production")))
+		Expect(filepath.Join(outputDir, "edge-001.url")).NotTo(BeAnExistingFile())
+		Expect(report.Results[2].Err).NotTo(HaveOccurred())
+	})
+
+	It("Registers nothing when a group does not exist", func() {
+		inventory[2].Groups = []string{"moon"}
+		_, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).To(MatchError("Cluster edge-003: Group moon does not exist"))
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(0))
+	})
+
+	It("Requires an output directory", func() {
+		opts.OutputDir = ""
+		_, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Registers no more than Concurrency clusters at once", func() {
+		var running, maxRunning int32
+		clusterService.RegisterClusterStub = func(orgID string, reg types.Registration) (*RegisterClusterResponseDataDetails, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return &RegisterClusterResponseDataDetails{ClusterID: reg.Name, URL: "https://over.there"}, nil
+		}
+		for i := 4; i <= 8; i++ {
+			inventory = append(inventory, InventoryEntry{Registration: types.Registration{Name: fmt.Sprintf("edge-%03d", i)}})
+		}
+		opts.Concurrency = 2
+
+		report, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(atomic.LoadInt32(&maxRunning)).To(Equal(int32(2)))
+	})
+
+	It("Limits the rate registrations are started at", func() {
+		opts.RequestsPerSecond = 20
+
+		start := time.Now()
+		_, err := RegisterInventory(ctx, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		// The first starts straight away, the other two wait 50ms each
+		Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+	})
+
+	It("Fails the clusters not yet started when the context is cancelled", func() {
+		cancelled, cancel := context.WithCancel(ctx)
+		clusterService.RegisterClusterStub = func(orgID string, reg types.Registration) (*RegisterClusterResponseDataDetails, error) {
+			cancel()
+			return &RegisterClusterResponseDataDetails{ClusterID: reg.Name, URL: "https://over.there"}, nil
+		}
+		opts.Concurrency = 1
+		opts.RequestsPerSecond = 10
+
+		report, err := RegisterInventory(cancelled, clusterService, groupService, orgID, inventory, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Results[0].Err).NotTo(HaveOccurred())
+		Expect(report.Results[2].Err).To(MatchError(context.Canceled))
+		Expect(report.Results[2].Name).To(Equal("edge-003"))
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(1))
+	})
+})
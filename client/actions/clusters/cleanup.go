@@ -0,0 +1,147 @@
+package clusters
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// CleanupOptions controls CleanupInactiveClusters
+type CleanupOptions struct {
+	// InactiveDays is how many days a cluster's agent must have gone without a
+	// heartbeat for the cluster to be cleaned up.  SatCon only reports clusters
+	// as inactive after one day, so this must be at least 1.
+	InactiveDays int
+	// DryRun reports what would be cleaned up without changing anything
+	DryRun bool
+}
+
+// CleanupResult is the outcome of cleaning up a single cluster.  In a dry run,
+// Groups and Deleted describe what would have been done.
+type CleanupResult struct {
+	ClusterID     string
+	Name          string
+	LastHeartbeat time.Time
+	// Groups are the UUIDs of the groups the cluster was removed from
+	Groups []string
+	// Deleted is set once the cluster has been deleted
+	Deleted              bool
+	DeletedResourceCount int
+	// Err is set if the cluster could not be cleaned up.  A cluster is only
+	// deleted once it has been removed from all of its groups.
+	Err error
+}
+
+// CleanupReport lists the result for every cluster CleanupInactiveClusters considered
+type CleanupReport struct {
+	DryRun  bool
+	Results []CleanupResult
+}
+
+// Deleted returns the number of clusters which were, or in a dry run would be, deleted
+func (r *CleanupReport) Deleted() int {
+	deleted := 0
+	for _, result := range r.Results {
+		if result.Deleted {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// Failed returns the results of the clusters which could not be cleaned up
+func (r *CleanupReport) Failed() []CleanupResult {
+	var failed []CleanupResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// CleanupInactiveClusters deletes the clusters of the organization whose agent has
+// not sent a heartbeat for opts.InactiveDays, after removing each one from its
+// groups with UnGroupClusters.  A failure to clean up one cluster does not stop
+// the others, so the error returned only covers listing the inactive clusters;
+// check the report for the outcome of each cluster.
+func CleanupInactiveClusters(clusterService ClusterService, groupService groups.GroupService, orgID string, opts CleanupOptions) (*CleanupReport, error) {
+	if opts.InactiveDays < 1 {
+		return nil, errors.New("Must supply at least 1 inactive day")
+	}
+
+	inactive, err := clusterService.InactiveClusters(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-time.Duration(opts.InactiveDays) * 24 * time.Hour)
+	report := &CleanupReport{DryRun: opts.DryRun}
+
+	for _, cluster := range inactive {
+		result := CleanupResult{
+			ClusterID: string(cluster.ClusterID),
+			Name:      cluster.Name,
+		}
+
+		result.LastHeartbeat, err = lastHeartbeat(cluster)
+		if err != nil {
+			result.Err = err
+			report.Results = append(report.Results, result)
+			continue
+		}
+		if result.LastHeartbeat.After(cutoff) {
+			continue
+		}
+
+		if opts.DryRun {
+			for _, group := range cluster.Groups {
+				result.Groups = append(result.Groups, string(group.UUID))
+			}
+			result.Deleted = true
+		} else {
+			cleanupCluster(clusterService, groupService, orgID, cluster, &result)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+func cleanupCluster(clusterService ClusterService, groupService groups.GroupService, orgID string, cluster types.Cluster, result *CleanupResult) {
+	for _, group := range cluster.Groups {
+		if _, err := groupService.UnGroupClusters(orgID, string(group.UUID), []string{string(cluster.ClusterID)}); err != nil {
+			result.Err = fmt.Errorf("Unable to remove cluster from group %s: %s", group.UUID, err)
+			return
+		}
+		result.Groups = append(result.Groups, string(group.UUID))
+	}
+
+	details, err := clusterService.DeleteClusterByClusterID(orgID, string(cluster.ClusterID))
+	if err != nil {
+		result.Err = fmt.Errorf("Unable to delete cluster: %s", err)
+		return
+	}
+
+	result.Deleted = true
+	if details != nil {
+		result.DeletedResourceCount = details.DeletedResourceCount
+	}
+}
+
+// lastHeartbeat returns when the cluster's agent last reported in, which is when
+// the cluster was last updated, or created if it never has been.
+func lastHeartbeat(cluster types.Cluster) (time.Time, error) {
+	t := cluster.Updated.Time
+	if t.IsZero() {
+		t = cluster.Created.Time
+	}
+	if t.IsZero() {
+		return time.Time{}, errors.New("Cluster has no updated or created time")
+	}
+	return t, nil
+}
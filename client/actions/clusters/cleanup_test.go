@@ -0,0 +1,144 @@
+package clusters_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/actions/clusters/clustersfakes"
+	"github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/actions/groups/groupsfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("CleanupInactiveClusters", func() {
+	var (
+		orgID          string
+		opts           CleanupOptions
+		clusterService *clustersfakes.FakeClusterService
+		groupService   *groupsfakes.FakeGroupService
+		daysAgo        func(days int) types.Timestamp
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		opts = CleanupOptions{InactiveDays: 7}
+		daysAgo = func(days int) types.Timestamp {
+			return types.Timestamp{Time: time.Now().Add(-time.Duration(days) * 24 * time.Hour)}
+		}
+
+		clusterService = &clustersfakes.FakeClusterService{}
+		clusterService.InactiveClustersReturns(types.ClusterList{
+			{
+				ClusterID: "stale",
+				Name:      "stale-cluster",
+				Updated:   daysAgo(30),
+				Groups: []types.ClusterGroup{
+					{UUID: "group1", Name: "production"},
+					{UUID: "group2", Name: "us-east"},
+				},
+			},
+			{
+				ClusterID: "recent",
+				Name:      "recent-cluster",
+				Updated:   daysAgo(2),
+			},
+			{
+				ClusterID: "never-updated",
+				Name:      "never-updated-cluster",
+				Created:   daysAgo(10),
+			},
+		}, nil)
+		clusterService.DeleteClusterByClusterIDReturns(&DeleteClustersResponseDataDetails{DeletedClusterCount: 1, DeletedResourceCount: 5}, nil)
+
+		groupService = &groupsfakes.FakeGroupService{}
+		groupService.UnGroupClustersReturns(&groups.UnGroupClustersResponseDataDetails{Modified: 1}, nil)
+	})
+
+	It("Ungroups and deletes the clusters inactive for long enough", func() {
+		report, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.DryRun).To(BeFalse())
+		Expect(report.Results).To(HaveLen(2))
+		Expect(report.Deleted()).To(Equal(2))
+		Expect(report.Failed()).To(BeEmpty())
+
+		stale := report.Results[0]
+		Expect(stale.ClusterID).To(Equal("stale"))
+		Expect(stale.Name).To(Equal("stale-cluster"))
+		Expect(stale.Groups).To(Equal([]string{"group1", "group2"}))
+		Expect(stale.DeletedResourceCount).To(Equal(5))
+		Expect(stale.LastHeartbeat).To(BeTemporally("~", time.Now().Add(-30*24*time.Hour), time.Minute))
+
+		Expect(groupService.UnGroupClustersCallCount()).To(Equal(2))
+		org, uuid, clusters := groupService.UnGroupClustersArgsForCall(1)
+		Expect(org).To(Equal(orgID))
+		Expect(uuid).To(Equal("group2"))
+		Expect(clusters).To(Equal([]string{"stale"}))
+
+		Expect(clusterService.DeleteClusterByClusterIDCallCount()).To(Equal(2))
+		_, clusterID := clusterService.DeleteClusterByClusterIDArgsForCall(1)
+		Expect(clusterID).To(Equal("never-updated"))
+	})
+
+	It("Changes nothing in a dry run", func() {
+		opts.DryRun = true
+		report, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(report.Deleted()).To(Equal(2))
+		Expect(report.Results[0].Groups).To(Equal([]string{"group1", "group2"}))
+
+		Expect(groupService.UnGroupClustersCallCount()).To(Equal(0))
+		Expect(clusterService.DeleteClusterByClusterIDCallCount()).To(Equal(0))
+	})
+
+	It("Does not delete a cluster it could not ungroup, but carries on with the others", func() {
+		groupService.UnGroupClustersReturnsOnCall(1, nil, errors.New("Kablooie!"))
+		report, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Deleted()).To(Equal(1))
+
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[0].ClusterID).To(Equal("stale"))
+		Expect(failed[0].Groups).To(Equal([]string{"group1"}))
+		Expect(failed[0].Err).To(MatchError(ContainSubstring("group2")))
+
+		Expect(clusterService.DeleteClusterByClusterIDCallCount()).To(Equal(1))
+	})
+
+	It("Reports clusters which could not be deleted", func() {
+		clusterService.DeleteClusterByClusterIDReturnsOnCall(0, nil, errors.New("Kablooie!"))
+		report, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Failed()).To(HaveLen(1))
+		Expect(report.Failed()[0].Deleted).To(BeFalse())
+		Expect(report.Failed()[0].Err).To(MatchError(ContainSubstring("Kablooie!")))
+	})
+
+	It("Reports clusters whose last heartbeat is unknown", func() {
+		clusterService.InactiveClustersReturns(types.ClusterList{{ClusterID: "mystery"}}, nil)
+		report, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Failed()).To(HaveLen(1))
+		Expect(report.Failed()[0].Err).To(MatchError("Cluster has no updated or created time"))
+		Expect(clusterService.DeleteClusterByClusterIDCallCount()).To(Equal(0))
+	})
+
+	It("Bubbles up errors listing the inactive clusters", func() {
+		clusterService.InactiveClustersReturns(nil, errors.New("Kablooie!"))
+		_, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).To(MatchError("Kablooie!"))
+	})
+
+	It("Errors when fewer than 1 inactive day is requested", func() {
+		opts.InactiveDays = 0
+		_, err := CleanupInactiveClusters(clusterService, groupService, orgID, opts)
+		Expect(err).To(HaveOccurred())
+		Expect(clusterService.InactiveClustersCallCount()).To(Equal(0))
+	})
+})
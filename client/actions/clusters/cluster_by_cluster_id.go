@@ -0,0 +1,65 @@
+package clusters
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryClusterByClusterID       = "clusterByClusterId"
+	ClusterByClusterIDVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"clusterId":{{json .ClusterID}}{{end}}`
+)
+
+// ClusterByClusterIDVariables are the variables specific to looking up a cluster
+// by its cluster ID.  Rather than instantiating this directly, use
+// NewClusterByClusterIDVariables().
+type ClusterByClusterIDVariables struct {
+	actions.GraphQLQuery
+	OrgID     string
+	ClusterID string
+}
+
+// NewClusterByClusterIDVariables creates a correctly formed instance of ClusterByClusterIDVariables.
+func NewClusterByClusterIDVariables(orgID, clusterID string) ClusterByClusterIDVariables {
+	vars := ClusterByClusterIDVariables{
+		OrgID:     orgID,
+		ClusterID: clusterID,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryClusterByClusterID
+	vars.Args = map[string]string{
+		"orgId":     "String!",
+		"clusterId": "String!",
+	}
+	vars.Returns = clusterDetailFields()
+
+	return vars
+}
+
+// ClusterByClusterID returns the cluster registered under the specified organization and cluster ID.
+func (c *Client) ClusterByClusterID(orgID, clusterID string) (*types.Cluster, error) {
+	vars := NewClusterByClusterIDVariables(orgID, clusterID)
+
+	return web.Do[*types.Cluster](&c.SatConClient, QueryClusterByClusterID, ClusterByClusterIDVarTemplate, vars, nil)
+}
+
+// clusterDetailFields returns the fields requested by every query returning
+// types.Cluster, so that each one fills in the cluster completely.
+func clusterDetailFields() []string {
+	return []string{
+		"id",
+		"orgId",
+		"clusterId",
+		"name",
+		"metadata",
+		"registration",
+		"regState",
+		"groups{uuid, name}",
+		"comments{user_id, content, created}",
+		"created",
+		"updated",
+		"dirty",
+	}
+}
@@ -0,0 +1,152 @@
+package clusters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("ClusterByClusterID", func() {
+	var (
+		orgID          string
+		clusterID      string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		clusterID = "cluster1"
+	})
+
+	Describe("NewClusterByClusterIDVariables", func() {
+		It("Returns a correctly populated instance of ClusterByClusterIDVariables", func() {
+			vars := NewClusterByClusterIDVariables(orgID, clusterID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QueryClusterByClusterID))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.ClusterID).To(Equal(clusterID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":     "String!",
+				"clusterId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"id",
+				"orgId",
+				"clusterId",
+				"name",
+				"metadata",
+				"registration",
+				"regState",
+				"groups{uuid, name}",
+				"comments{user_id, content, created}",
+				"created",
+				"updated",
+				"dirty",
+			))
+		})
+	})
+
+	Describe("ClusterByClusterID", func() {
+		var (
+			c               ClusterService
+			httpClient      *webfakes.FakeHTTPClient
+			response        *http.Response
+			clusterResponse *types.Cluster
+		)
+
+		BeforeEach(func() {
+			clusterResponse = &types.Cluster{
+				ID:        "asdf",
+				OrgID:     types.OrgID(orgID),
+				ClusterID: "cluster1",
+				Name:      "cluster1",
+				Registration: types.Registration{
+					Name: "cluster1",
+				},
+				RegistrationState: "registered",
+				Groups: []types.ClusterGroup{
+					{UUID: "group1", Name: "production"},
+				},
+				Comments: []types.Comment{
+					{UserId: "user1", Content: "Migrated from us-east", Created: types.Timestamp{Time: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)}},
+				},
+				Created: types.Timestamp{Time: time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)},
+				Updated: types.Timestamp{Time: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)},
+				Dirty:   true,
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryClusterByClusterID: clusterResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			httpClient = &webfakes.FakeHTTPClient{}
+			Expect(httpClient.DoCallCount()).To(Equal(0))
+			httpClient.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", httpClient, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.ClusterByClusterID(orgID, clusterID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpClient.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the cluster with all of its details", func() {
+			clusters, _ := c.ClusterByClusterID(orgID, clusterID)
+			expected := clusterResponse
+			Expect(clusters).To(Equal(expected))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				httpClient.DoReturns(response, errors.New("None whatsoever, Frank."))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.ClusterByClusterID(orgID, clusterID)
+				Expect(err).To(MatchError(MatchRegexp("None whatsoever, Frank.")))
+			})
+		})
+
+		Context("When the cluster does not exist", func() {
+			BeforeEach(func() {
+				response.Body = ioutil.NopCloser(bytes.NewBufferString(`{"data":{"clusterByClusterId":null}}`))
+			})
+
+			It("Returns an ErrNotFound", func() {
+				cluster, err := c.ClusterByClusterID(orgID, clusterID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrNotFound{}))
+				Expect(cluster).To(BeNil())
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				clusters, err := c.ClusterByClusterID(orgID, clusterID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(clusters).To(BeNil())
+			})
+		})
+	})
+})
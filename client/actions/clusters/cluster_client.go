@@ -19,9 +19,22 @@ type ClusterService interface {
 	ClustersByOrgID(orgID string) (types.ClusterList, error)
 	// ClusterByName returns the cluster registered under the specified organization and name.
 	ClusterByName(orgID string, clusterName string) (*types.Cluster, error)
+	// ClusterByClusterID returns the cluster registered under the specified organization and cluster ID.
+	ClusterByClusterID(orgID string, clusterID string) (*types.Cluster, error)
+	// ClusterSearch returns up to limit clusters of the specified org matching filter.
+	ClusterSearch(orgID string, filter string, limit int) (types.ClusterList, error)
+	// ClusterCountByKubeVersion returns how many clusters of the specified org run each Kubernetes version.
+	ClusterCountByKubeVersion(orgID string) ([]types.ClusterCountByKubeVersion, error)
 	// DeleteClusterByClusterID deletes the specified cluster from the specified org,
 	// including all resources under that cluster.
 	DeleteClusterByClusterID(orgID string, clusterID string) (*DeleteClustersResponseDataDetails, error)
+	// DeleteClusters deletes every cluster of the specified org, including all
+	// resources under those clusters.
+	DeleteClusters(orgID string) (*DeleteClustersResponseDataDetails, error)
+	// InactiveClusters lists the clusters of the specified org whose agent has not reported in for over a day.
+	InactiveClusters(orgID string) (types.ClusterList, error)
+	// EnableRegistrationURL re-enables the registration URL of the specified cluster, see RegistrationManifest.
+	EnableRegistrationURL(orgID string, clusterID string) (*EnableRegistrationURLResponseDataDetails, error)
 }
 
 // Client is an implementation of a satcon client.
@@ -0,0 +1,47 @@
+package clusters
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryClusterCountByKubeVersion       = "clusterCountByKubeVersion"
+	ClusterCountByKubeVersionVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}}{{end}}`
+)
+
+// ClusterCountByKubeVersionVariables are the variables specific to counting clusters
+// by Kubernetes version.  Rather than instantiating this directly, use
+// NewClusterCountByKubeVersionVariables().
+type ClusterCountByKubeVersionVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+}
+
+// NewClusterCountByKubeVersionVariables creates a correctly formed instance of ClusterCountByKubeVersionVariables.
+func NewClusterCountByKubeVersionVariables(orgID string) ClusterCountByKubeVersionVariables {
+	vars := ClusterCountByKubeVersionVariables{
+		OrgID: orgID,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryClusterCountByKubeVersion
+	vars.Args = map[string]string{
+		"orgId": "String!",
+	}
+	vars.Returns = []string{
+		"id{major, minor}",
+		"count",
+	}
+
+	return vars
+}
+
+// ClusterCountByKubeVersion returns how many clusters of the specified org run each
+// Kubernetes major.minor version.
+func (c *Client) ClusterCountByKubeVersion(orgID string) ([]types.ClusterCountByKubeVersion, error) {
+	vars := NewClusterCountByKubeVersionVariables(orgID)
+
+	return web.Do[[]types.ClusterCountByKubeVersion](&c.SatConClient, QueryClusterCountByKubeVersion, ClusterCountByKubeVersionVarTemplate, vars, nil)
+}
@@ -0,0 +1,108 @@
+package clusters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("ClusterCountByKubeVersion", func() {
+	var (
+		orgID          string
+		c              ClusterService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewClusterCountByKubeVersionVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewClusterCountByKubeVersionVariables(orgID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QueryClusterCountByKubeVersion))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"id{major, minor}",
+				"count",
+			))
+		})
+	})
+
+	Describe("ClusterCountByKubeVersion", func() {
+		var countResponse []types.ClusterCountByKubeVersion
+
+		BeforeEach(func() {
+			countResponse = []types.ClusterCountByKubeVersion{
+				{ID: types.KubeVersion{Major: "1", Minor: "26"}, Count: 12},
+				{ID: types.KubeVersion{Major: "1", Minor: "27"}, Count: 30},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryClusterCountByKubeVersion: countResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the http request", func() {
+			_, err := c.ClusterCountByKubeVersion(orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the cluster counts", func() {
+			details, _ := c.ClusterCountByKubeVersion(orgID)
+			Expect(details).To(Equal(countResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.ClusterCountByKubeVersion(orgID)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.ClusterCountByKubeVersion(orgID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package clusters
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryClusterSearch       = "clusterSearch"
+	ClusterSearchVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"filter":{{json .Filter}}{{if gt .Limit 0}},"limit":{{json .Limit}}{{end}}{{end}}`
+)
+
+// ClusterSearchVariables are the variables specific to searching for clusters.
+// Rather than instantiating this directly, use NewClusterSearchVariables().
+type ClusterSearchVariables struct {
+	actions.GraphQLQuery
+	OrgID  string
+	Filter string
+	Limit  int
+}
+
+// NewClusterSearchVariables creates a correctly formed instance of ClusterSearchVariables.
+func NewClusterSearchVariables(orgID, filter string, limit int) ClusterSearchVariables {
+	vars := ClusterSearchVariables{
+		OrgID:  orgID,
+		Filter: filter,
+		Limit:  limit,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryClusterSearch
+	vars.Args = map[string]string{
+		"orgId":  "String!",
+		"filter": "String",
+		"limit":  "Int",
+	}
+	vars.Returns = clusterDetailFields()
+
+	return vars
+}
+
+// ClusterSearch returns up to limit clusters of the specified org matching filter,
+// which SatCon matches against the cluster ID, name and metadata.  A limit of 0
+// or less is not sent, leaving SatCon's default limit to apply.
+func (c *Client) ClusterSearch(orgID, filter string, limit int) (types.ClusterList, error) {
+	vars := NewClusterSearchVariables(orgID, filter, limit)
+
+	return web.Do[types.ClusterList](&c.SatConClient, QueryClusterSearch, ClusterSearchVarTemplate, vars, nil)
+}
@@ -0,0 +1,168 @@
+package clusters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("ClusterSearch", func() {
+	var (
+		orgID, filter  string
+		limit          int
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		filter = "cluster"
+		limit = 2
+
+	})
+
+	Describe("NewClusterSearchVariables", func() {
+		It("Returns a correctly populated instance of ClusterSearchVariables", func() {
+			vars := NewClusterSearchVariables(orgID, filter, limit)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QueryClusterSearch))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Filter).To(Equal(filter))
+			Expect(vars.Limit).To(Equal(limit))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":  "String!",
+				"filter": "String",
+				"limit":  "Int",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"id",
+				"orgId",
+				"clusterId",
+				"name",
+				"metadata",
+				"registration",
+				"regState",
+				"groups{uuid, name}",
+				"comments{user_id, content, created}",
+				"created",
+				"updated",
+				"dirty",
+			))
+		})
+	})
+
+	Describe("ClusterSearch", func() {
+		var (
+			c               ClusterService
+			httpClient      *webfakes.FakeHTTPClient
+			response        *http.Response
+			clusterResponse types.ClusterList
+		)
+
+		BeforeEach(func() {
+			clusterResponse = types.ClusterList{
+				{
+					ID:        "asdf",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster1",
+					Name:      "cluster1",
+				},
+				{
+					ID:        "qwer",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster2",
+					Name:      "cluster2",
+				},
+				{
+					ID:        "xzcv",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster3",
+					Name:      "cluster3",
+				},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryClusterSearch: clusterResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			httpClient = &webfakes.FakeHTTPClient{}
+			Expect(httpClient.DoCallCount()).To(Equal(0))
+			httpClient.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", httpClient, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.ClusterSearch(orgID, filter, limit)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpClient.DoCallCount()).To(Equal(1))
+		})
+
+		It("Sends the filter and limit", func() {
+			_, err := c.ClusterSearch(orgID, filter, limit)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(httpClient.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("filter", filter))
+			Expect(body.Variables).To(HaveKeyWithValue("limit", BeNumerically("==", limit)))
+		})
+
+		It("Leaves out the limit when it is not positive", func() {
+			_, err := c.ClusterSearch(orgID, filter, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(httpClient.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("filter", filter))
+			Expect(body.Variables).NotTo(HaveKey("limit"))
+		})
+
+		It("Returns the matching clusters", func() {
+			clusters, _ := c.ClusterSearch(orgID, filter, limit)
+			expected := clusterResponse
+			Expect(clusters).To(Equal(expected))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				httpClient.DoReturns(response, errors.New("Fart Monkeys!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.ClusterSearch(orgID, filter, limit)
+				Expect(err).To(MatchError(MatchRegexp("Fart Monkeys!")))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				clusters, err := c.ClusterSearch(orgID, filter, limit)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(clusters).To(BeNil())
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package clusters
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -28,39 +29,13 @@ func NewClusterByNameVariables(orgID string, clusterName string) ClusterByNameVa
 		"orgId":       "String!",
 		"clusterName": "String!",
 	}
-	vars.Returns = []string{
-		"id",
-		"orgId",
-		"clusterId",
-		"name",
-		"metadata",
-	}
+	vars.Returns = clusterDetailFields()
 
 	return vars
 }
 
-type ClusterByNameResponse struct {
-	Data *ClusterByNameResponseData `json:"data,omitempty"`
-}
-
-type ClusterByNameResponseData struct {
-	Cluster *types.Cluster `json:"clusterByName,omitempty"`
-}
-
 func (c *Client) ClusterByName(orgID string, clusterName string) (*types.Cluster, error) {
-	var response ClusterByNameResponse
-
 	vars := NewClusterByNameVariables(orgID, clusterName)
 
-	err := c.DoQuery(ClusterByNameVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Cluster, nil
-	}
-
-	return nil, err
+	return web.Do[*types.Cluster](&c.SatConClient, QueryClusterByName, ClusterByNameVarTemplate, vars, nil)
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/clusters"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -46,6 +47,13 @@ var _ = Describe("ClusterByName", func() {
 				"clusterId",
 				"name",
 				"metadata",
+				"registration",
+				"regState",
+				"groups{uuid, name}",
+				"comments{user_id, content, created}",
+				"created",
+				"updated",
+				"dirty",
 			))
 		})
 	})
@@ -55,22 +63,18 @@ var _ = Describe("ClusterByName", func() {
 			c               ClusterService
 			httpClient      *webfakes.FakeHTTPClient
 			response        *http.Response
-			clusterResponse ClusterByNameResponse
+			clusterResponse *types.Cluster
 		)
 
 		BeforeEach(func() {
-			clusterResponse = ClusterByNameResponse{
-				Data: &ClusterByNameResponseData{
-					&types.Cluster{
-						ID:        "asdf",
-						OrgID:     orgID,
-						ClusterID: "cluster1",
-						Name:      "cluster1",
-					},
-				},
+			clusterResponse = &types.Cluster{
+				ID:        "asdf",
+				OrgID:     types.OrgID(orgID),
+				ClusterID: "cluster1",
+				Name:      "cluster1",
 			}
 
-			respBodyBytes, err := json.Marshal(clusterResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryClusterByName: clusterResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -91,7 +95,7 @@ var _ = Describe("ClusterByName", func() {
 
 		It("Returns a cluster by name", func() {
 			clusters, _ := c.ClusterByName(orgID, clusterName)
-			expected := clusterResponse.Data.Cluster
+			expected := clusterResponse
 			Expect(clusters).To(Equal(expected))
 		})
 
@@ -106,15 +110,27 @@ var _ = Describe("ClusterByName", func() {
 			})
 		})
 
+		Context("When the cluster does not exist", func() {
+			BeforeEach(func() {
+				response.Body = ioutil.NopCloser(bytes.NewBufferString(`{"data":{"clusterByName":null}}`))
+			})
+
+			It("Returns an ErrNotFound", func() {
+				cluster, err := c.ClusterByName(orgID, clusterName)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrNotFound{}))
+				Expect(cluster).To(BeNil())
+			})
+		})
+
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ClustersByOrgIDResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				clusters, err := c.ClusterByName(orgID, clusterName)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(clusters).To(BeNil())
 			})
 		})
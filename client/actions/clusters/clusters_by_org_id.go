@@ -3,6 +3,7 @@ package clusters
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -25,39 +26,13 @@ func NewClustersByOrgIDVariables(orgID string) ClustersByOrgIDVariables {
 	vars.Args = map[string]string{
 		"orgId": "String!",
 	}
-	vars.Returns = []string{
-		"id",
-		"orgId",
-		"clusterId",
-		"name",
-		"metadata",
-	}
+	vars.Returns = clusterDetailFields()
 
 	return vars
 }
 
-type ClustersByOrgIDResponse struct {
-	Data *ClustersByOrgIDResponseData `json:"data,omitempty"`
-}
-
-type ClustersByOrgIDResponseData struct {
-	Clusters types.ClusterList `json:"clustersByOrgId,omitempty"`
-}
-
 func (c *Client) ClustersByOrgID(orgID string) (types.ClusterList, error) {
-	var response ClustersByOrgIDResponse
-
 	vars := NewClustersByOrgIDVariables(orgID)
 
-	err := c.DoQuery(ClustersByOrgIDVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Clusters, nil
-	}
-
-	return nil, err
+	return web.Do[types.ClusterList](&c.SatConClient, QueryClustersByOrgID, ClustersByOrgIDVarTemplate, vars, nil)
 }
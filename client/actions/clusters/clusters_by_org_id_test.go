@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/clusters"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -43,6 +44,13 @@ var _ = Describe("ClustersByOrgId", func() {
 				"clusterId",
 				"name",
 				"metadata",
+				"registration",
+				"regState",
+				"groups{uuid, name}",
+				"comments{user_id, content, created}",
+				"created",
+				"updated",
+				"dirty",
 			))
 		})
 	})
@@ -52,36 +60,32 @@ var _ = Describe("ClustersByOrgId", func() {
 			c               ClusterService
 			httpClient      *webfakes.FakeHTTPClient
 			response        *http.Response
-			clusterResponse ClustersByOrgIDResponse
+			clusterResponse types.ClusterList
 		)
 
 		BeforeEach(func() {
-			clusterResponse = ClustersByOrgIDResponse{
-				Data: &ClustersByOrgIDResponseData{
-					Clusters: types.ClusterList{
-						{
-							ID:        "asdf",
-							OrgID:     orgID,
-							ClusterID: "cluster1",
-							Name:      "cluster1",
-						},
-						{
-							ID:        "qwer",
-							OrgID:     orgID,
-							ClusterID: "cluster2",
-							Name:      "cluster2",
-						},
-						{
-							ID:        "xzcv",
-							OrgID:     orgID,
-							ClusterID: "cluster3",
-							Name:      "cluster3",
-						},
-					},
+			clusterResponse = types.ClusterList{
+				{
+					ID:        "asdf",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster1",
+					Name:      "cluster1",
+				},
+				{
+					ID:        "qwer",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster2",
+					Name:      "cluster2",
+				},
+				{
+					ID:        "xzcv",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster3",
+					Name:      "cluster3",
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(clusterResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryClustersByOrgID: clusterResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -102,7 +106,7 @@ var _ = Describe("ClustersByOrgId", func() {
 
 		It("Returns the list of clusters", func() {
 			clusters, _ := c.ClustersByOrgID(orgID)
-			expected := clusterResponse.Data.Clusters
+			expected := clusterResponse
 			Expect(clusters).To(Equal(expected))
 		})
 
@@ -119,13 +123,13 @@ var _ = Describe("ClustersByOrgId", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ClustersByOrgIDResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				clusters, err := c.ClustersByOrgID(orgID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(clusters).To(BeNil())
 			})
 		})
@@ -9,6 +9,20 @@ import (
 )
 
 type FakeClusterService struct {
+	ClusterByClusterIDStub        func(string, string) (*types.Cluster, error)
+	clusterByClusterIDMutex       sync.RWMutex
+	clusterByClusterIDArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	clusterByClusterIDReturns struct {
+		result1 *types.Cluster
+		result2 error
+	}
+	clusterByClusterIDReturnsOnCall map[int]struct {
+		result1 *types.Cluster
+		result2 error
+	}
 	ClusterByNameStub        func(string, string) (*types.Cluster, error)
 	clusterByNameMutex       sync.RWMutex
 	clusterByNameArgsForCall []struct {
@@ -23,6 +37,34 @@ type FakeClusterService struct {
 		result1 *types.Cluster
 		result2 error
 	}
+	ClusterCountByKubeVersionStub        func(string) ([]types.ClusterCountByKubeVersion, error)
+	clusterCountByKubeVersionMutex       sync.RWMutex
+	clusterCountByKubeVersionArgsForCall []struct {
+		arg1 string
+	}
+	clusterCountByKubeVersionReturns struct {
+		result1 []types.ClusterCountByKubeVersion
+		result2 error
+	}
+	clusterCountByKubeVersionReturnsOnCall map[int]struct {
+		result1 []types.ClusterCountByKubeVersion
+		result2 error
+	}
+	ClusterSearchStub        func(string, string, int) (types.ClusterList, error)
+	clusterSearchMutex       sync.RWMutex
+	clusterSearchArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	clusterSearchReturns struct {
+		result1 types.ClusterList
+		result2 error
+	}
+	clusterSearchReturnsOnCall map[int]struct {
+		result1 types.ClusterList
+		result2 error
+	}
 	ClustersByOrgIDStub        func(string) (types.ClusterList, error)
 	clustersByOrgIDMutex       sync.RWMutex
 	clustersByOrgIDArgsForCall []struct {
@@ -50,6 +92,46 @@ type FakeClusterService struct {
 		result1 *clusters.DeleteClustersResponseDataDetails
 		result2 error
 	}
+	DeleteClustersStub        func(string) (*clusters.DeleteClustersResponseDataDetails, error)
+	deleteClustersMutex       sync.RWMutex
+	deleteClustersArgsForCall []struct {
+		arg1 string
+	}
+	deleteClustersReturns struct {
+		result1 *clusters.DeleteClustersResponseDataDetails
+		result2 error
+	}
+	deleteClustersReturnsOnCall map[int]struct {
+		result1 *clusters.DeleteClustersResponseDataDetails
+		result2 error
+	}
+	EnableRegistrationURLStub        func(string, string) (*clusters.EnableRegistrationURLResponseDataDetails, error)
+	enableRegistrationURLMutex       sync.RWMutex
+	enableRegistrationURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	enableRegistrationURLReturns struct {
+		result1 *clusters.EnableRegistrationURLResponseDataDetails
+		result2 error
+	}
+	enableRegistrationURLReturnsOnCall map[int]struct {
+		result1 *clusters.EnableRegistrationURLResponseDataDetails
+		result2 error
+	}
+	InactiveClustersStub        func(string) (types.ClusterList, error)
+	inactiveClustersMutex       sync.RWMutex
+	inactiveClustersArgsForCall []struct {
+		arg1 string
+	}
+	inactiveClustersReturns struct {
+		result1 types.ClusterList
+		result2 error
+	}
+	inactiveClustersReturnsOnCall map[int]struct {
+		result1 types.ClusterList
+		result2 error
+	}
 	RegisterClusterStub        func(string, types.Registration) (*clusters.RegisterClusterResponseDataDetails, error)
 	registerClusterMutex       sync.RWMutex
 	registerClusterArgsForCall []struct {
@@ -68,6 +150,71 @@ type FakeClusterService struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeClusterService) ClusterByClusterID(arg1 string, arg2 string) (*types.Cluster, error) {
+	fake.clusterByClusterIDMutex.Lock()
+	ret, specificReturn := fake.clusterByClusterIDReturnsOnCall[len(fake.clusterByClusterIDArgsForCall)]
+	fake.clusterByClusterIDArgsForCall = append(fake.clusterByClusterIDArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ClusterByClusterIDStub
+	fakeReturns := fake.clusterByClusterIDReturns
+	fake.recordInvocation("ClusterByClusterID", []interface{}{arg1, arg2})
+	fake.clusterByClusterIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClusterService) ClusterByClusterIDCallCount() int {
+	fake.clusterByClusterIDMutex.RLock()
+	defer fake.clusterByClusterIDMutex.RUnlock()
+	return len(fake.clusterByClusterIDArgsForCall)
+}
+
+func (fake *FakeClusterService) ClusterByClusterIDCalls(stub func(string, string) (*types.Cluster, error)) {
+	fake.clusterByClusterIDMutex.Lock()
+	defer fake.clusterByClusterIDMutex.Unlock()
+	fake.ClusterByClusterIDStub = stub
+}
+
+func (fake *FakeClusterService) ClusterByClusterIDArgsForCall(i int) (string, string) {
+	fake.clusterByClusterIDMutex.RLock()
+	defer fake.clusterByClusterIDMutex.RUnlock()
+	argsForCall := fake.clusterByClusterIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClusterService) ClusterByClusterIDReturns(result1 *types.Cluster, result2 error) {
+	fake.clusterByClusterIDMutex.Lock()
+	defer fake.clusterByClusterIDMutex.Unlock()
+	fake.ClusterByClusterIDStub = nil
+	fake.clusterByClusterIDReturns = struct {
+		result1 *types.Cluster
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) ClusterByClusterIDReturnsOnCall(i int, result1 *types.Cluster, result2 error) {
+	fake.clusterByClusterIDMutex.Lock()
+	defer fake.clusterByClusterIDMutex.Unlock()
+	fake.ClusterByClusterIDStub = nil
+	if fake.clusterByClusterIDReturnsOnCall == nil {
+		fake.clusterByClusterIDReturnsOnCall = make(map[int]struct {
+			result1 *types.Cluster
+			result2 error
+		})
+	}
+	fake.clusterByClusterIDReturnsOnCall[i] = struct {
+		result1 *types.Cluster
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClusterService) ClusterByName(arg1 string, arg2 string) (*types.Cluster, error) {
 	fake.clusterByNameMutex.Lock()
 	ret, specificReturn := fake.clusterByNameReturnsOnCall[len(fake.clusterByNameArgsForCall)]
@@ -133,6 +280,136 @@ func (fake *FakeClusterService) ClusterByNameReturnsOnCall(i int, result1 *types
 	}{result1, result2}
 }
 
+func (fake *FakeClusterService) ClusterCountByKubeVersion(arg1 string) ([]types.ClusterCountByKubeVersion, error) {
+	fake.clusterCountByKubeVersionMutex.Lock()
+	ret, specificReturn := fake.clusterCountByKubeVersionReturnsOnCall[len(fake.clusterCountByKubeVersionArgsForCall)]
+	fake.clusterCountByKubeVersionArgsForCall = append(fake.clusterCountByKubeVersionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ClusterCountByKubeVersionStub
+	fakeReturns := fake.clusterCountByKubeVersionReturns
+	fake.recordInvocation("ClusterCountByKubeVersion", []interface{}{arg1})
+	fake.clusterCountByKubeVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClusterService) ClusterCountByKubeVersionCallCount() int {
+	fake.clusterCountByKubeVersionMutex.RLock()
+	defer fake.clusterCountByKubeVersionMutex.RUnlock()
+	return len(fake.clusterCountByKubeVersionArgsForCall)
+}
+
+func (fake *FakeClusterService) ClusterCountByKubeVersionCalls(stub func(string) ([]types.ClusterCountByKubeVersion, error)) {
+	fake.clusterCountByKubeVersionMutex.Lock()
+	defer fake.clusterCountByKubeVersionMutex.Unlock()
+	fake.ClusterCountByKubeVersionStub = stub
+}
+
+func (fake *FakeClusterService) ClusterCountByKubeVersionArgsForCall(i int) string {
+	fake.clusterCountByKubeVersionMutex.RLock()
+	defer fake.clusterCountByKubeVersionMutex.RUnlock()
+	argsForCall := fake.clusterCountByKubeVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClusterService) ClusterCountByKubeVersionReturns(result1 []types.ClusterCountByKubeVersion, result2 error) {
+	fake.clusterCountByKubeVersionMutex.Lock()
+	defer fake.clusterCountByKubeVersionMutex.Unlock()
+	fake.ClusterCountByKubeVersionStub = nil
+	fake.clusterCountByKubeVersionReturns = struct {
+		result1 []types.ClusterCountByKubeVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) ClusterCountByKubeVersionReturnsOnCall(i int, result1 []types.ClusterCountByKubeVersion, result2 error) {
+	fake.clusterCountByKubeVersionMutex.Lock()
+	defer fake.clusterCountByKubeVersionMutex.Unlock()
+	fake.ClusterCountByKubeVersionStub = nil
+	if fake.clusterCountByKubeVersionReturnsOnCall == nil {
+		fake.clusterCountByKubeVersionReturnsOnCall = make(map[int]struct {
+			result1 []types.ClusterCountByKubeVersion
+			result2 error
+		})
+	}
+	fake.clusterCountByKubeVersionReturnsOnCall[i] = struct {
+		result1 []types.ClusterCountByKubeVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) ClusterSearch(arg1 string, arg2 string, arg3 int) (types.ClusterList, error) {
+	fake.clusterSearchMutex.Lock()
+	ret, specificReturn := fake.clusterSearchReturnsOnCall[len(fake.clusterSearchArgsForCall)]
+	fake.clusterSearchArgsForCall = append(fake.clusterSearchArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ClusterSearchStub
+	fakeReturns := fake.clusterSearchReturns
+	fake.recordInvocation("ClusterSearch", []interface{}{arg1, arg2, arg3})
+	fake.clusterSearchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClusterService) ClusterSearchCallCount() int {
+	fake.clusterSearchMutex.RLock()
+	defer fake.clusterSearchMutex.RUnlock()
+	return len(fake.clusterSearchArgsForCall)
+}
+
+func (fake *FakeClusterService) ClusterSearchCalls(stub func(string, string, int) (types.ClusterList, error)) {
+	fake.clusterSearchMutex.Lock()
+	defer fake.clusterSearchMutex.Unlock()
+	fake.ClusterSearchStub = stub
+}
+
+func (fake *FakeClusterService) ClusterSearchArgsForCall(i int) (string, string, int) {
+	fake.clusterSearchMutex.RLock()
+	defer fake.clusterSearchMutex.RUnlock()
+	argsForCall := fake.clusterSearchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClusterService) ClusterSearchReturns(result1 types.ClusterList, result2 error) {
+	fake.clusterSearchMutex.Lock()
+	defer fake.clusterSearchMutex.Unlock()
+	fake.ClusterSearchStub = nil
+	fake.clusterSearchReturns = struct {
+		result1 types.ClusterList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) ClusterSearchReturnsOnCall(i int, result1 types.ClusterList, result2 error) {
+	fake.clusterSearchMutex.Lock()
+	defer fake.clusterSearchMutex.Unlock()
+	fake.ClusterSearchStub = nil
+	if fake.clusterSearchReturnsOnCall == nil {
+		fake.clusterSearchReturnsOnCall = make(map[int]struct {
+			result1 types.ClusterList
+			result2 error
+		})
+	}
+	fake.clusterSearchReturnsOnCall[i] = struct {
+		result1 types.ClusterList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClusterService) ClustersByOrgID(arg1 string) (types.ClusterList, error) {
 	fake.clustersByOrgIDMutex.Lock()
 	ret, specificReturn := fake.clustersByOrgIDReturnsOnCall[len(fake.clustersByOrgIDArgsForCall)]
@@ -262,6 +539,199 @@ func (fake *FakeClusterService) DeleteClusterByClusterIDReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeClusterService) DeleteClusters(arg1 string) (*clusters.DeleteClustersResponseDataDetails, error) {
+	fake.deleteClustersMutex.Lock()
+	ret, specificReturn := fake.deleteClustersReturnsOnCall[len(fake.deleteClustersArgsForCall)]
+	fake.deleteClustersArgsForCall = append(fake.deleteClustersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteClustersStub
+	fakeReturns := fake.deleteClustersReturns
+	fake.recordInvocation("DeleteClusters", []interface{}{arg1})
+	fake.deleteClustersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClusterService) DeleteClustersCallCount() int {
+	fake.deleteClustersMutex.RLock()
+	defer fake.deleteClustersMutex.RUnlock()
+	return len(fake.deleteClustersArgsForCall)
+}
+
+func (fake *FakeClusterService) DeleteClustersCalls(stub func(string) (*clusters.DeleteClustersResponseDataDetails, error)) {
+	fake.deleteClustersMutex.Lock()
+	defer fake.deleteClustersMutex.Unlock()
+	fake.DeleteClustersStub = stub
+}
+
+func (fake *FakeClusterService) DeleteClustersArgsForCall(i int) string {
+	fake.deleteClustersMutex.RLock()
+	defer fake.deleteClustersMutex.RUnlock()
+	argsForCall := fake.deleteClustersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClusterService) DeleteClustersReturns(result1 *clusters.DeleteClustersResponseDataDetails, result2 error) {
+	fake.deleteClustersMutex.Lock()
+	defer fake.deleteClustersMutex.Unlock()
+	fake.DeleteClustersStub = nil
+	fake.deleteClustersReturns = struct {
+		result1 *clusters.DeleteClustersResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) DeleteClustersReturnsOnCall(i int, result1 *clusters.DeleteClustersResponseDataDetails, result2 error) {
+	fake.deleteClustersMutex.Lock()
+	defer fake.deleteClustersMutex.Unlock()
+	fake.DeleteClustersStub = nil
+	if fake.deleteClustersReturnsOnCall == nil {
+		fake.deleteClustersReturnsOnCall = make(map[int]struct {
+			result1 *clusters.DeleteClustersResponseDataDetails
+			result2 error
+		})
+	}
+	fake.deleteClustersReturnsOnCall[i] = struct {
+		result1 *clusters.DeleteClustersResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) EnableRegistrationURL(arg1 string, arg2 string) (*clusters.EnableRegistrationURLResponseDataDetails, error) {
+	fake.enableRegistrationURLMutex.Lock()
+	ret, specificReturn := fake.enableRegistrationURLReturnsOnCall[len(fake.enableRegistrationURLArgsForCall)]
+	fake.enableRegistrationURLArgsForCall = append(fake.enableRegistrationURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.EnableRegistrationURLStub
+	fakeReturns := fake.enableRegistrationURLReturns
+	fake.recordInvocation("EnableRegistrationURL", []interface{}{arg1, arg2})
+	fake.enableRegistrationURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClusterService) EnableRegistrationURLCallCount() int {
+	fake.enableRegistrationURLMutex.RLock()
+	defer fake.enableRegistrationURLMutex.RUnlock()
+	return len(fake.enableRegistrationURLArgsForCall)
+}
+
+func (fake *FakeClusterService) EnableRegistrationURLCalls(stub func(string, string) (*clusters.EnableRegistrationURLResponseDataDetails, error)) {
+	fake.enableRegistrationURLMutex.Lock()
+	defer fake.enableRegistrationURLMutex.Unlock()
+	fake.EnableRegistrationURLStub = stub
+}
+
+func (fake *FakeClusterService) EnableRegistrationURLArgsForCall(i int) (string, string) {
+	fake.enableRegistrationURLMutex.RLock()
+	defer fake.enableRegistrationURLMutex.RUnlock()
+	argsForCall := fake.enableRegistrationURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClusterService) EnableRegistrationURLReturns(result1 *clusters.EnableRegistrationURLResponseDataDetails, result2 error) {
+	fake.enableRegistrationURLMutex.Lock()
+	defer fake.enableRegistrationURLMutex.Unlock()
+	fake.EnableRegistrationURLStub = nil
+	fake.enableRegistrationURLReturns = struct {
+		result1 *clusters.EnableRegistrationURLResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) EnableRegistrationURLReturnsOnCall(i int, result1 *clusters.EnableRegistrationURLResponseDataDetails, result2 error) {
+	fake.enableRegistrationURLMutex.Lock()
+	defer fake.enableRegistrationURLMutex.Unlock()
+	fake.EnableRegistrationURLStub = nil
+	if fake.enableRegistrationURLReturnsOnCall == nil {
+		fake.enableRegistrationURLReturnsOnCall = make(map[int]struct {
+			result1 *clusters.EnableRegistrationURLResponseDataDetails
+			result2 error
+		})
+	}
+	fake.enableRegistrationURLReturnsOnCall[i] = struct {
+		result1 *clusters.EnableRegistrationURLResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) InactiveClusters(arg1 string) (types.ClusterList, error) {
+	fake.inactiveClustersMutex.Lock()
+	ret, specificReturn := fake.inactiveClustersReturnsOnCall[len(fake.inactiveClustersArgsForCall)]
+	fake.inactiveClustersArgsForCall = append(fake.inactiveClustersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.InactiveClustersStub
+	fakeReturns := fake.inactiveClustersReturns
+	fake.recordInvocation("InactiveClusters", []interface{}{arg1})
+	fake.inactiveClustersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClusterService) InactiveClustersCallCount() int {
+	fake.inactiveClustersMutex.RLock()
+	defer fake.inactiveClustersMutex.RUnlock()
+	return len(fake.inactiveClustersArgsForCall)
+}
+
+func (fake *FakeClusterService) InactiveClustersCalls(stub func(string) (types.ClusterList, error)) {
+	fake.inactiveClustersMutex.Lock()
+	defer fake.inactiveClustersMutex.Unlock()
+	fake.InactiveClustersStub = stub
+}
+
+func (fake *FakeClusterService) InactiveClustersArgsForCall(i int) string {
+	fake.inactiveClustersMutex.RLock()
+	defer fake.inactiveClustersMutex.RUnlock()
+	argsForCall := fake.inactiveClustersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClusterService) InactiveClustersReturns(result1 types.ClusterList, result2 error) {
+	fake.inactiveClustersMutex.Lock()
+	defer fake.inactiveClustersMutex.Unlock()
+	fake.InactiveClustersStub = nil
+	fake.inactiveClustersReturns = struct {
+		result1 types.ClusterList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClusterService) InactiveClustersReturnsOnCall(i int, result1 types.ClusterList, result2 error) {
+	fake.inactiveClustersMutex.Lock()
+	defer fake.inactiveClustersMutex.Unlock()
+	fake.InactiveClustersStub = nil
+	if fake.inactiveClustersReturnsOnCall == nil {
+		fake.inactiveClustersReturnsOnCall = make(map[int]struct {
+			result1 types.ClusterList
+			result2 error
+		})
+	}
+	fake.inactiveClustersReturnsOnCall[i] = struct {
+		result1 types.ClusterList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClusterService) RegisterCluster(arg1 string, arg2 types.Registration) (*clusters.RegisterClusterResponseDataDetails, error) {
 	fake.registerClusterMutex.Lock()
 	ret, specificReturn := fake.registerClusterReturnsOnCall[len(fake.registerClusterArgsForCall)]
@@ -330,12 +800,24 @@ func (fake *FakeClusterService) RegisterClusterReturnsOnCall(i int, result1 *clu
 func (fake *FakeClusterService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clusterByClusterIDMutex.RLock()
+	defer fake.clusterByClusterIDMutex.RUnlock()
 	fake.clusterByNameMutex.RLock()
 	defer fake.clusterByNameMutex.RUnlock()
+	fake.clusterCountByKubeVersionMutex.RLock()
+	defer fake.clusterCountByKubeVersionMutex.RUnlock()
+	fake.clusterSearchMutex.RLock()
+	defer fake.clusterSearchMutex.RUnlock()
 	fake.clustersByOrgIDMutex.RLock()
 	defer fake.clustersByOrgIDMutex.RUnlock()
 	fake.deleteClusterByClusterIDMutex.RLock()
 	defer fake.deleteClusterByClusterIDMutex.RUnlock()
+	fake.deleteClustersMutex.RLock()
+	defer fake.deleteClustersMutex.RUnlock()
+	fake.enableRegistrationURLMutex.RLock()
+	defer fake.enableRegistrationURLMutex.RUnlock()
+	fake.inactiveClustersMutex.RLock()
+	defer fake.inactiveClustersMutex.RUnlock()
 	fake.registerClusterMutex.RLock()
 	defer fake.registerClusterMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -35,33 +36,13 @@ func NewDeleteClusterByClusterIDVariables(orgID, clusterID string) DeleteCluster
 	return vars
 }
 
-type DeleteClustersResponse struct {
-	Data *DeleteClustersResponseData `json:"data,omitempty"`
-}
-
-type DeleteClustersResponseData struct {
-	Details *DeleteClustersResponseDataDetails `json:"deleteClusterByClusterId,omitempty"`
-}
-
 type DeleteClustersResponseDataDetails struct {
 	DeletedClusterCount  int `json:"deletedClusterCount,omitempty"`
 	DeletedResourceCount int `json:"deletedResourceCount,omitempty"`
 }
 
 func (c *Client) DeleteClusterByClusterID(orgID, clusterID string) (*DeleteClustersResponseDataDetails, error) {
-	var response DeleteClustersResponse
-
 	vars := NewDeleteClusterByClusterIDVariables(orgID, clusterID)
 
-	err := c.DoQuery(DeleteClusterByClusterIDVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*DeleteClustersResponseDataDetails](&c.SatConClient, QueryDeleteClusterByClusterID, DeleteClusterByClusterIDVarTemplate, vars, nil)
 }
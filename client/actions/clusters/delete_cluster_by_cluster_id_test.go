@@ -13,6 +13,7 @@ import (
 	// "github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/clusters"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -54,20 +55,16 @@ var _ = Describe("DeleteClusterByClusterId", func() {
 
 	Describe("DeleteClusterByClusterID", func() {
 		var (
-			delResponse DeleteClustersResponse
+			delResponse *DeleteClustersResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			delResponse = DeleteClustersResponse{
-				Data: &DeleteClustersResponseData{
-					Details: &DeleteClustersResponseDataDetails{
-						DeletedClusterCount:  1,
-						DeletedResourceCount: 0,
-					},
-				},
+			delResponse = &DeleteClustersResponseDataDetails{
+				DeletedClusterCount:  1,
+				DeletedResourceCount: 0,
 			}
 
-			respBodyBytes, err := json.Marshal(delResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryDeleteClusterByClusterID: delResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -82,7 +79,7 @@ var _ = Describe("DeleteClusterByClusterId", func() {
 		It("Returns the response details", func() {
 			details, _ := c.DeleteClusterByClusterID(orgID, clusterID)
 
-			expected := delResponse.Data.Details
+			expected := delResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -99,13 +96,13 @@ var _ = Describe("DeleteClusterByClusterId", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(DeleteClustersResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.DeleteClusterByClusterID(orgID, clusterID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -0,0 +1,46 @@
+package clusters
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryDeleteClusters       = "deleteClusters"
+	DeleteClustersVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}}{{end}}`
+)
+
+// DeleteClustersVariables are the variables specific to deleting all of the
+// clusters of an organization.  Rather than instantiating this directly, use
+// NewDeleteClustersVariables().
+type DeleteClustersVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+}
+
+// NewDeleteClustersVariables creates a correctly formed instance of DeleteClustersVariables.
+func NewDeleteClustersVariables(orgID string) DeleteClustersVariables {
+	vars := DeleteClustersVariables{
+		OrgID: orgID,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryDeleteClusters
+	vars.Args = map[string]string{
+		"orgId": "String!",
+	}
+	vars.Returns = []string{
+		"deletedClusterCount",
+		"deletedResourceCount",
+	}
+
+	return vars
+}
+
+// DeleteClusters deletes every cluster of the specified org, including all
+// resources under those clusters.
+func (c *Client) DeleteClusters(orgID string) (*DeleteClustersResponseDataDetails, error) {
+	vars := NewDeleteClustersVariables(orgID)
+
+	return web.Do[*DeleteClustersResponseDataDetails](&c.SatConClient, QueryDeleteClusters, DeleteClustersVarTemplate, vars, nil)
+}
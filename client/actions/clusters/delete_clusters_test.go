@@ -0,0 +1,107 @@
+package clusters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("DeleteClusters", func() {
+	var (
+		orgID          string
+		c              ClusterService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewDeleteClustersVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewDeleteClustersVariables(orgID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryDeleteClusters))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"deletedClusterCount",
+				"deletedResourceCount",
+			))
+		})
+	})
+
+	Describe("DeleteClusters", func() {
+		var deleteResponse *DeleteClustersResponseDataDetails
+
+		BeforeEach(func() {
+			deleteResponse = &DeleteClustersResponseDataDetails{
+				DeletedClusterCount:  3,
+				DeletedResourceCount: 42,
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryDeleteClusters: deleteResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the http request", func() {
+			_, err := c.DeleteClusters(orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the deleted counts", func() {
+			details, _ := c.DeleteClusters(orgID)
+			Expect(details).To(Equal(deleteResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.DeleteClusters(orgID)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.DeleteClusters(orgID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package clusters
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEnableRegistrationURL       = "enableRegistrationUrl"
+	EnableRegistrationURLVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"clusterId":{{json .ClusterID}}{{end}}`
+)
+
+// EnableRegistrationURLVariables are the variables specific to re-enabling the
+// registration URL of a cluster.  Rather than instantiating this directly, use
+// NewEnableRegistrationURLVariables().
+type EnableRegistrationURLVariables struct {
+	actions.GraphQLQuery
+	OrgID     string
+	ClusterID string
+}
+
+// NewEnableRegistrationURLVariables creates a correctly formed instance of EnableRegistrationURLVariables.
+func NewEnableRegistrationURLVariables(orgID, clusterID string) EnableRegistrationURLVariables {
+	vars := EnableRegistrationURLVariables{
+		OrgID:     orgID,
+		ClusterID: clusterID,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEnableRegistrationURL
+	vars.Args = map[string]string{
+		"orgId":     "String!",
+		"clusterId": "String!",
+	}
+	vars.Returns = []string{
+		"url",
+	}
+
+	return vars
+}
+
+type EnableRegistrationURLResponseDataDetails struct {
+	URL string `json:"url,omitempty"`
+}
+
+// EnableRegistrationURL re-enables the registration URL of an already registered
+// cluster, e.g. to reinstall its Razee agent, and returns the URL.
+func (c *Client) EnableRegistrationURL(orgID, clusterID string) (*EnableRegistrationURLResponseDataDetails, error) {
+	vars := NewEnableRegistrationURLVariables(orgID, clusterID)
+
+	return web.Do[*EnableRegistrationURLResponseDataDetails](&c.SatConClient, QueryEnableRegistrationURL, EnableRegistrationURLVarTemplate, vars, nil)
+}
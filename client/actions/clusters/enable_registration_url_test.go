@@ -0,0 +1,108 @@
+package clusters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("EnableRegistrationURL", func() {
+	var (
+		orgID, clusterID string
+		c                ClusterService
+		h                *webfakes.FakeHTTPClient
+		response         *http.Response
+		fakeAuthClient   authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		clusterID = "somecluster"
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewEnableRegistrationURLVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewEnableRegistrationURLVariables(orgID, clusterID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryEnableRegistrationURL))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.ClusterID).To(Equal(clusterID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":     "String!",
+				"clusterId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"url",
+			))
+		})
+	})
+
+	Describe("EnableRegistrationURL", func() {
+		var urlResponse *EnableRegistrationURLResponseDataDetails
+
+		BeforeEach(func() {
+			urlResponse = &EnableRegistrationURLResponseDataDetails{
+				URL: "https://foo.bar/api/install/cluster?orgKey=key&clusterId=somecluster",
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryEnableRegistrationURL: urlResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the http request", func() {
+			_, err := c.EnableRegistrationURL(orgID, clusterID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the registration URL", func() {
+			details, _ := c.EnableRegistrationURL(orgID, clusterID)
+			Expect(details).To(Equal(urlResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.EnableRegistrationURL(orgID, clusterID)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.EnableRegistrationURL(orgID, clusterID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package clusters
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryInactiveClusters       = "inactiveClusters"
+	InactiveClustersVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}}{{end}}`
+)
+
+// InactiveClustersVariables are the variables specific to listing inactive clusters.
+// Rather than instantiating this directly, use NewInactiveClustersVariables().
+type InactiveClustersVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+}
+
+// NewInactiveClustersVariables creates a correctly formed instance of InactiveClustersVariables.
+func NewInactiveClustersVariables(orgID string) InactiveClustersVariables {
+	vars := InactiveClustersVariables{
+		OrgID: orgID,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryInactiveClusters
+	vars.Args = map[string]string{
+		"orgId": "String!",
+	}
+	vars.Returns = clusterDetailFields()
+
+	return vars
+}
+
+// InactiveClusters lists the clusters of the specified org whose Razee agent has
+// not reported in for over a day.  Updated is the time of the last heartbeat.
+func (c *Client) InactiveClusters(orgID string) (types.ClusterList, error) {
+	vars := NewInactiveClustersVariables(orgID)
+
+	return web.Do[types.ClusterList](&c.SatConClient, QueryInactiveClusters, InactiveClustersVarTemplate, vars, nil)
+}
@@ -0,0 +1,137 @@
+package clusters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("InactiveClusters", func() {
+	var (
+		orgID          string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+
+	})
+
+	Describe("NewInactiveClustersVariables", func() {
+		It("Returns a correctly populated instance of InactiveClustersVariables", func() {
+			vars := NewInactiveClustersVariables(orgID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QueryInactiveClusters))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"id",
+				"orgId",
+				"clusterId",
+				"name",
+				"metadata",
+				"registration",
+				"regState",
+				"groups{uuid, name}",
+				"comments{user_id, content, created}",
+				"created",
+				"updated",
+				"dirty",
+			))
+		})
+	})
+
+	Describe("InactiveClusters", func() {
+		var (
+			c               ClusterService
+			httpClient      *webfakes.FakeHTTPClient
+			response        *http.Response
+			clusterResponse types.ClusterList
+		)
+
+		BeforeEach(func() {
+			clusterResponse = types.ClusterList{
+				{
+					ID:        "asdf",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster1",
+					Name:      "cluster1",
+				},
+				{
+					ID:        "qwer",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster2",
+					Name:      "cluster2",
+				},
+				{
+					ID:        "xzcv",
+					OrgID:     types.OrgID(orgID),
+					ClusterID: "cluster3",
+					Name:      "cluster3",
+				},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryInactiveClusters: clusterResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			httpClient = &webfakes.FakeHTTPClient{}
+			Expect(httpClient.DoCallCount()).To(Equal(0))
+			httpClient.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", httpClient, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.InactiveClusters(orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpClient.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the list of inactive clusters", func() {
+			clusters, _ := c.InactiveClusters(orgID)
+			expected := clusterResponse
+			Expect(clusters).To(Equal(expected))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				httpClient.DoReturns(response, errors.New("Fart Monkeys!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.InactiveClusters(orgID)
+				Expect(err).To(MatchError(MatchRegexp("Fart Monkeys!")))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				clusters, err := c.InactiveClusters(orgID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(clusters).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,186 @@
+package clusters
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// InventoryEntry is a cluster to register, as read from an inventory file
+type InventoryEntry struct {
+	Registration types.Registration
+	// Groups are the names of the groups to add the cluster to
+	Groups []string
+}
+
+// ReadInventory reads the inventory file at path, choosing the format from its
+// extension: .csv for ParseCSVInventory, or .yaml/.yml for ParseYAMLInventory.
+func ReadInventory(path string) ([]InventoryEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ParseCSVInventory(f)
+	case ".yaml", ".yml":
+		return ParseYAMLInventory(f)
+	default:
+		return nil, fmt.Errorf("Unsupported inventory format %s, must be .csv, .yaml or .yml", filepath.Ext(path))
+	}
+}
+
+// ParseCSVInventory parses a CSV inventory.  The first row is a header naming the
+// columns: "name" is required, "groups" is a semicolon separated list of group
+// names, "labels" is a semicolon separated list of key=value pairs, and any other
+// column becomes a registration field of that name.  Empty cells are ignored.
+//
+//	name,groups,location,labels,rack
+//	edge-001,production;site-a,us-east,env=prod;tier=edge,12
+func ParseCSVInventory(r io.Reader) ([]InventoryEntry, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("Inventory has no header row")
+	}
+
+	header := rows[0]
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var entries []InventoryEntry
+	for i, row := range rows[1:] {
+		fields := map[string]interface{}{}
+		var groups []string
+		for col, cell := range row {
+			cell = strings.TrimSpace(cell)
+			if cell == "" {
+				continue
+			}
+
+			switch header[col] {
+			case "groups":
+				groups = splitList(cell)
+			case "labels":
+				labels := map[string]string{}
+				for _, pair := range splitList(cell) {
+					key, value, ok := strings.Cut(pair, "=")
+					if !ok {
+						return nil, fmt.Errorf("Inventory row %d: label %q must be key=value", i+2, pair)
+					}
+					labels[strings.TrimSpace(key)] = strings.TrimSpace(value)
+				}
+				fields["labels"] = labels
+			default:
+				fields[header[col]] = cell
+			}
+		}
+
+		entry, err := newInventoryEntry(fields, groups)
+		if err != nil {
+			return nil, fmt.Errorf("Inventory row %d: %s", i+2, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, checkInventory(entries)
+}
+
+// ParseYAMLInventory parses a YAML inventory, which is a list of clusters.  Each
+// has a name, optional list of groups, and any other registration fields.
+//
+//	# inventory.yaml
+//	- name: edge-001
+//	  groups: [production, site-a]
+//	  location: us-east
+//	  labels:
+//	    env: prod
+//	  rack: 12
+func ParseYAMLInventory(r io.Reader) ([]InventoryEntry, error) {
+	var clusters []map[string]interface{}
+	if err := yaml.NewDecoder(r).Decode(&clusters); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	var entries []InventoryEntry
+	for i, fields := range clusters {
+		var groups []string
+		if value, ok := fields["groups"]; ok {
+			list, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Inventory cluster %d: groups must be a list", i+1)
+			}
+			for _, group := range list {
+				groups = append(groups, fmt.Sprint(group))
+			}
+			delete(fields, "groups")
+		}
+
+		entry, err := newInventoryEntry(fields, groups)
+		if err != nil {
+			return nil, fmt.Errorf("Inventory cluster %d: %s", i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, checkInventory(entries)
+}
+
+// newInventoryEntry builds an entry from the registration fields of a cluster.
+func newInventoryEntry(fields map[string]interface{}, groups []string) (InventoryEntry, error) {
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return InventoryEntry{}, err
+	}
+
+	entry := InventoryEntry{Groups: groups}
+	if err = json.Unmarshal(b, &entry.Registration); err != nil {
+		return InventoryEntry{}, err
+	}
+	if err = entry.Registration.Validate(); err != nil {
+		return InventoryEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// checkInventory rejects inventories listing a cluster more than once.  Clusters
+// are also compared by output file name, as each one needs a file of its own.
+func checkInventory(entries []InventoryEntry) error {
+	seen := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		filename := urlFilename(entry.Registration.Name)
+		if other, ok := seen[filename]; ok {
+			if other == entry.Registration.Name {
+				return fmt.Errorf("Inventory lists cluster %s more than once", other)
+			}
+			return fmt.Errorf("Inventory clusters %s and %s would share the output file %s", other, entry.Registration.Name, filename)
+		}
+		seen[filename] = entry.Registration.Name
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,128 @@
+package clusters_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("Inventory", func() {
+	var expected []InventoryEntry
+
+	BeforeEach(func() {
+		expected = []InventoryEntry{
+			{
+				Registration: types.Registration{
+					Name:     "edge-001",
+					Location: "us-east",
+					Labels:   map[string]string{"env": "prod", "tier": "edge"},
+					Extra:    map[string]interface{}{"rack": "12"},
+				},
+				Groups: []string{"production", "site-a"},
+			},
+			{
+				Registration: types.Registration{Name: "edge-002"},
+			},
+		}
+	})
+
+	Describe("ParseCSVInventory", func() {
+		It("Parses each row into a registration and its groups", func() {
+			entries, err := ParseCSVInventory(strings.NewReader(
+				"name,groups,location,labels,rack\n" +
+					"edge-001,production; site-a,us-east,env=prod;tier=edge,12\n" +
+					"edge-002,,,,\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal(expected))
+		})
+
+		It("Errors on rows without a name", func() {
+			_, err := ParseCSVInventory(strings.NewReader("name,location\n,us-east\n"))
+			Expect(err).To(MatchError("Inventory row 2: Must supply a cluster name"))
+		})
+
+		It("Errors on malformed labels", func() {
+			_, err := ParseCSVInventory(strings.NewReader("name,labels\nedge-001,prod\n"))
+			Expect(err).To(MatchError(ContainSubstring("key=value")))
+		})
+
+		It("Errors on an empty file", func() {
+			_, err := ParseCSVInventory(strings.NewReader(""))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Errors on duplicate clusters", func() {
+			_, err := ParseCSVInventory(strings.NewReader("name\nedge-001\nedge-001\n"))
+			Expect(err).To(MatchError("Inventory lists cluster edge-001 more than once"))
+		})
+
+		It("Errors on clusters which would share an output file", func() {
+			_, err := ParseCSVInventory(strings.NewReader("name\nedge/001\nedge:001\n"))
+			Expect(err).To(MatchError(ContainSubstring("edge_001.url")))
+		})
+	})
+
+	Describe("ParseYAMLInventory", func() {
+		It("Parses each cluster into a registration and its groups", func() {
+			expected[0].Registration.Extra["rack"] = float64(12)
+			entries, err := ParseYAMLInventory(strings.NewReader(`
+- name: edge-001
+  groups: [production, site-a]
+  location: us-east
+  labels:
+    env: prod
+    tier: edge
+  rack: 12
+- name: edge-002
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal(expected))
+		})
+
+		It("Errors when groups is not a list", func() {
+			_, err := ParseYAMLInventory(strings.NewReader("- name: edge-001\n  groups: production\n"))
+			Expect(err).To(MatchError("Inventory cluster 1: groups must be a list"))
+		})
+
+		It("Returns nothing for an empty file", func() {
+			entries, err := ParseYAMLInventory(strings.NewReader(""))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+
+	Describe("ReadInventory", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		It("Reads CSV and YAML files", func() {
+			csvFile := filepath.Join(dir, "inventory.csv")
+			Expect(os.WriteFile(csvFile, []byte("name\nedge-002\n"), 0o600)).To(Succeed())
+			entries, err := ReadInventory(csvFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal(expected[1:]))
+
+			yamlFile := filepath.Join(dir, "inventory.yml")
+			Expect(os.WriteFile(yamlFile, []byte("- name: edge-002\n"), 0o600)).To(Succeed())
+			entries, err = ReadInventory(yamlFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal(expected[1:]))
+		})
+
+		It("Errors on other formats", func() {
+			jsonFile := filepath.Join(dir, "inventory.json")
+			Expect(os.WriteFile(jsonFile, []byte("[]"), 0o600)).To(Succeed())
+			_, err := ReadInventory(jsonFile)
+			Expect(err).To(MatchError(ContainSubstring("Unsupported inventory format")))
+		})
+	})
+})
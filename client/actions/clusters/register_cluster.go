@@ -4,10 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 
-	// "io/ioutil"
-
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -25,8 +24,16 @@ type RegisterClusterVariables struct {
 }
 
 // NewRegisterClusterVariables creates a correctly formed instance of RegisterClusterVariables.
-func NewRegisterClusterVariables(orgID string, registration types.Registration) RegisterClusterVariables {
-	regBytes, _ := json.Marshal(registration)
+// It returns an error if the registration is invalid, see types.Registration.Validate().
+func NewRegisterClusterVariables(orgID string, registration types.Registration) (RegisterClusterVariables, error) {
+	if err := registration.Validate(); err != nil {
+		return RegisterClusterVariables{}, err
+	}
+
+	regBytes, err := json.Marshal(registration)
+	if err != nil {
+		return RegisterClusterVariables{}, err
+	}
 
 	vars := RegisterClusterVariables{
 		OrgID:        orgID,
@@ -48,17 +55,7 @@ func NewRegisterClusterVariables(orgID string, registration types.Registration)
 		"registration",
 	}
 
-	return vars
-}
-
-// RegisterClusterResponse is the response body we get upon a successful cluster
-// registration.
-type RegisterClusterResponse struct {
-	Data *RegisterClusterResponseData `json:"data,omitempty"`
-}
-
-type RegisterClusterResponseData struct {
-	Details *RegisterClusterResponseDataDetails `json:"registerCluster,omitempty"`
+	return vars, nil
 }
 
 type RegisterClusterResponseDataDetails struct {
@@ -76,19 +73,10 @@ func (d RegisterClusterResponseDataDetails) String() string {
 }
 
 func (c *Client) RegisterCluster(orgID string, registration types.Registration) (*RegisterClusterResponseDataDetails, error) {
-	var response RegisterClusterResponse
-
-	vars := NewRegisterClusterVariables(orgID, registration)
-
-	err := c.DoQuery(RegisterClusterVarTemplate, vars, nil, &response)
-
+	vars, err := NewRegisterClusterVariables(orgID, registration)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*RegisterClusterResponseDataDetails](&c.SatConClient, QueryRegisterCluster, RegisterClusterVarTemplate, vars, nil)
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/clusters"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -48,7 +49,8 @@ var _ = Describe("Registering a Cluster", func() {
 
 	Describe("NewRegisterClusterVariables", func() {
 		It("Returns a correctly configured set of variables", func() {
-			vars := NewRegisterClusterVariables(orgID, reg)
+			vars, err := NewRegisterClusterVariables(orgID, reg)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
 			Expect(vars.QueryName).To(Equal(QueryRegisterCluster))
 			Expect(vars.OrgID).To(Equal(orgID))
@@ -67,28 +69,39 @@ var _ = Describe("Registering a Cluster", func() {
 				"registration",
 			))
 		})
+
+		It("Serializes every registration field", func() {
+			reg.Location = "us-east"
+			reg.Labels = map[string]string{"env": "prod"}
+			reg.Extra = map[string]interface{}{"rack": 12}
+
+			vars, err := NewRegisterClusterVariables(orgID, reg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars.Registration).To(MatchJSON(`{"name":"my_cluster","location":"us-east","labels":{"env":"prod"},"rack":12}`))
+		})
+
+		It("Errors on an invalid registration", func() {
+			_, err := NewRegisterClusterVariables(orgID, types.Registration{})
+			Expect(err).To(MatchError("Must supply a cluster name"))
+		})
 	})
 
 	Describe("RegisterCluster", func() {
 		var (
-			regResponse RegisterClusterResponse
+			regResponse *RegisterClusterResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			regResponse = RegisterClusterResponse{
-				Data: &RegisterClusterResponseData{
-					Details: &RegisterClusterResponseDataDetails{
-						URL:          "https://over.there",
-						OrgID:        orgID,
-						OrgKey:       "whatshouldakeylooklike",
-						ClusterID:    "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
-						RegState:     "Faaaabulous!",
-						Registration: reg,
-					},
-				},
+			regResponse = &RegisterClusterResponseDataDetails{
+				URL:          "https://over.there",
+				OrgID:        orgID,
+				OrgKey:       "whatshouldakeylooklike",
+				ClusterID:    "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
+				RegState:     "Faaaabulous!",
+				Registration: reg,
 			}
 
-			respBodyBytes, err := json.Marshal(regResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryRegisterCluster: regResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -104,10 +117,16 @@ var _ = Describe("Registering a Cluster", func() {
 			details, _ := c.RegisterCluster(orgID, reg)
 			Expect(details).NotTo(BeNil())
 
-			expected := regResponse.Data.Details
+			expected := regResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
+		It("Does not send an invalid registration", func() {
+			_, err := c.RegisterCluster(orgID, types.Registration{})
+			Expect(err).To(HaveOccurred())
+			Expect(HTTPClient.DoCallCount()).To(Equal(0))
+		})
+
 		Context("When query execution errors", func() {
 			BeforeEach(func() {
 				HTTPClient.DoReturns(response, errors.New("Fart Monkeys!"))
@@ -121,13 +140,13 @@ var _ = Describe("Registering a Cluster", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(RegisterClusterResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.RegisterCluster(orgID, reg)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -0,0 +1,86 @@
+package clusters
+
+import (
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// RegisterClusterWithGroups registers a cluster and adds it to the named groups.
+// The registration and group names are checked before anything is changed, so
+// an invalid registration or unknown group registers nothing.  If the cluster is
+// registered but cannot be added to a group, the registration details are
+// returned along with the error, so the grouping can be retried.
+func RegisterClusterWithGroups(clusterService ClusterService, groupService groups.GroupService, orgID string, registration types.Registration, groupNames []string) (*RegisterClusterResponseDataDetails, error) {
+	if err := registration.Validate(); err != nil {
+		return nil, err
+	}
+
+	groupUUIDs, err := groupUUIDsByName(groupService, orgID, groupNames)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := clusterService.RegisterCluster(orgID, registration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = addToGroups(groupService, orgID, details.ClusterID, groupUUIDs, groupNames); err != nil {
+		return details, fmt.Errorf("Cluster %s was registered, but %s", details.ClusterID, err)
+	}
+
+	return details, nil
+}
+
+// addToGroups adds the cluster to each group, groupNames being used for errors.
+func addToGroups(groupService groups.GroupService, orgID, clusterID string, groupUUIDs, groupNames []string) error {
+	for i, uuid := range groupUUIDs {
+		if _, err := groupService.GroupClusters(orgID, uuid, []string{clusterID}); err != nil {
+			return fmt.Errorf("could not be added to group %s: %s", groupNames[i], err)
+		}
+	}
+	return nil
+}
+
+// groupUUIDsByName looks up the UUIDs of the named groups of the organization, in order.
+func groupUUIDsByName(groupService groups.GroupService, orgID string, groupNames []string) ([]string, error) {
+	if len(groupNames) == 0 {
+		return nil, nil
+	}
+
+	uuids, err := groupUUIDIndex(groupService, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lookupGroupUUIDs(uuids, groupNames)
+}
+
+// groupUUIDIndex maps the names of the organization's groups to their UUIDs.
+func groupUUIDIndex(groupService groups.GroupService, orgID string) (map[string]string, error) {
+	orgGroups, err := groupService.Groups(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make(map[string]string, len(orgGroups))
+	for _, group := range orgGroups {
+		uuids[group.Name] = string(group.UUID)
+	}
+	return uuids, nil
+}
+
+func lookupGroupUUIDs(uuids map[string]string, groupNames []string) ([]string, error) {
+	groupUUIDs := make([]string, len(groupNames))
+	for i, name := range groupNames {
+		uuid, ok := uuids[name]
+		if !ok {
+			return nil, fmt.Errorf("Group %s does not exist", name)
+		}
+		groupUUIDs[i] = uuid
+	}
+
+	return groupUUIDs, nil
+}
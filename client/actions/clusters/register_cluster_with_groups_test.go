@@ -0,0 +1,93 @@
+package clusters_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/actions/clusters/clustersfakes"
+	"github.com/IBM/satcon-client-go/client/actions/groups/groupsfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("RegisterClusterWithGroups", func() {
+	var (
+		orgID          string
+		reg            types.Registration
+		groupNames     []string
+		clusterService *clustersfakes.FakeClusterService
+		groupService   *groupsfakes.FakeGroupService
+		regDetails     *RegisterClusterResponseDataDetails
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		reg = types.Registration{Name: "my_cluster", Location: "us-east"}
+		groupNames = []string{"production", "us-east"}
+
+		regDetails = &RegisterClusterResponseDataDetails{ClusterID: "new-cluster", URL: "https://over.there"}
+		clusterService = &clustersfakes.FakeClusterService{}
+		clusterService.RegisterClusterReturns(regDetails, nil)
+
+		groupService = &groupsfakes.FakeGroupService{}
+		groupService.GroupsReturns(types.GroupList{
+			{UUID: "group1", Name: "production"},
+			{UUID: "group2", Name: "us-east"},
+			{UUID: "group3", Name: "staging"},
+		}, nil)
+	})
+
+	It("Registers the cluster and adds it to each group", func() {
+		details, err := RegisterClusterWithGroups(clusterService, groupService, orgID, reg, groupNames)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(details).To(Equal(regDetails))
+
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(1))
+		regOrgID, registration := clusterService.RegisterClusterArgsForCall(0)
+		Expect(regOrgID).To(Equal(orgID))
+		Expect(registration).To(Equal(reg))
+
+		Expect(groupService.GroupClustersCallCount()).To(Equal(2))
+		groupOrgID, uuid, clusters := groupService.GroupClustersArgsForCall(0)
+		Expect(groupOrgID).To(Equal(orgID))
+		Expect(uuid).To(Equal("group1"))
+		Expect(clusters).To(Equal([]string{"new-cluster"}))
+		_, uuid, _ = groupService.GroupClustersArgsForCall(1)
+		Expect(uuid).To(Equal("group2"))
+	})
+
+	It("Does not look up groups when there are none", func() {
+		_, err := RegisterClusterWithGroups(clusterService, groupService, orgID, reg, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groupService.GroupsCallCount()).To(Equal(0))
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(1))
+	})
+
+	It("Registers nothing when the registration is invalid", func() {
+		_, err := RegisterClusterWithGroups(clusterService, groupService, orgID, types.Registration{}, groupNames)
+		Expect(err).To(HaveOccurred())
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(0))
+	})
+
+	It("Registers nothing when a group does not exist", func() {
+		_, err := RegisterClusterWithGroups(clusterService, groupService, orgID, reg, []string{"production", "moon"})
+		Expect(err).To(MatchError("Group moon does not exist"))
+		Expect(clusterService.RegisterClusterCallCount()).To(Equal(0))
+	})
+
+	It("Bubbles up registration errors", func() {
+		clusterService.RegisterClusterReturns(nil, errors.New("Kablooie!"))
+		_, err := RegisterClusterWithGroups(clusterService, groupService, orgID, reg, groupNames)
+		Expect(err).To(MatchError("Kablooie!"))
+		Expect(groupService.GroupClustersCallCount()).To(Equal(0))
+	})
+
+	It("Returns the registration when grouping fails", func() {
+		groupService.GroupClustersReturnsOnCall(1, nil, errors.New("Kablooie!"))
+		details, err := RegisterClusterWithGroups(clusterService, groupService, orgID, reg, groupNames)
+		Expect(err).To(MatchError(ContainSubstring("us-east")))
+		Expect(details).To(Equal(regDetails))
+	})
+})
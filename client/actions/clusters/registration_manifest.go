@@ -0,0 +1,138 @@
+package clusters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+// ManifestObject is a single Kubernetes object from a manifest, in the same
+// unstructured form used by kubectl, i.e. as decoded from JSON with numbers as
+// float64.  It converts directly to client-go's unstructured.Unstructured{Object: obj}.
+type ManifestObject map[string]interface{}
+
+// APIVersion returns the apiVersion of the object
+func (o ManifestObject) APIVersion() string {
+	s, _ := o["apiVersion"].(string)
+	return s
+}
+
+// Kind returns the kind of the object
+func (o ManifestObject) Kind() string {
+	s, _ := o["kind"].(string)
+	return s
+}
+
+// Name returns metadata.name of the object
+func (o ManifestObject) Name() string {
+	return o.metadata("name")
+}
+
+// Namespace returns metadata.namespace of the object, which is empty for
+// cluster-scoped objects and objects in the default namespace
+func (o ManifestObject) Namespace() string {
+	return o.metadata("namespace")
+}
+
+func (o ManifestObject) metadata(field string) string {
+	metadata, _ := o["metadata"].(map[string]interface{})
+	s, _ := metadata[field].(string)
+	return s
+}
+
+// RegistrationManifest downloads the Razee agent install YAML from a registration
+// URL, as returned by RegisterCluster or EnableRegistrationURL, and parses it into
+// its Kubernetes objects.  The URL carries its own credentials, so no auth client
+// is needed.  A nil httpClient uses http.DefaultClient.
+func RegistrationManifest(httpClient web.HTTPClient, url string) ([]ManifestObject, error) {
+	if url == "" {
+		return nil, errors.New("Must supply a registration URL")
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if response.Body != nil {
+		defer response.Body.Close()
+		body, err = ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, fmt.Errorf("Unable to download registration manifest: %d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	return ParseManifest(body)
+}
+
+// ParseManifest splits a multi-document YAML manifest into its Kubernetes objects.
+// Empty documents are skipped, and a document which is not a Kubernetes object
+// (i.e. has no apiVersion or kind) is an error.
+func ParseManifest(manifest []byte) ([]ManifestObject, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(manifest))
+
+	var objects []ManifestObject
+	for i := 1; ; i++ {
+		var doc map[string]interface{}
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse manifest document %d: %s", i, err)
+		}
+
+		if len(doc) == 0 {
+			continue
+		}
+		obj, err := normalizeManifestObject(doc)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse manifest document %d: %s", i, err)
+		}
+		if obj.APIVersion() == "" || obj.Kind() == "" {
+			return nil, fmt.Errorf("Manifest document %d is not a Kubernetes object", i)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// normalizeManifestObject round-trips a YAML document through JSON, so that its
+// values have the types JSON decoding produces.  YAML decodes numbers as int,
+// which client-go's unstructured objects do not accept.
+func normalizeManifestObject(doc map[string]interface{}) (ManifestObject, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj ManifestObject
+	if err = json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,140 @@
+package clusters_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+const registrationYAML = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: razeedeploy
+---
+# The agent identity
+apiVersion: v1
+kind: Secret
+metadata:
+  name: razee-identity
+  namespace: razeedeploy
+data:
+  RAZEE_ORG_KEY: a2V5
+---
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: razeedeploy-job
+  namespace: razeedeploy
+spec:
+  template:
+    spec:
+      containers:
+      - name: razeedeploy-job
+        args: ["install", "--namespace=razeedeploy"]
+`
+
+var _ = Describe("RegistrationManifest", func() {
+	var (
+		url      string
+		h        *webfakes.FakeHTTPClient
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		url = "https://foo.bar/api/install/cluster?orgKey=key&clusterId=somecluster"
+		response = &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(registrationYAML)),
+		}
+		h = &webfakes.FakeHTTPClient{}
+		h.DoReturns(response, nil)
+	})
+
+	It("Downloads and parses the manifest", func() {
+		objects, err := RegistrationManifest(h, url)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(h.DoCallCount()).To(Equal(1))
+		req := h.DoArgsForCall(0)
+		Expect(req.Method).To(Equal(http.MethodGet))
+		Expect(req.URL.String()).To(Equal(url))
+
+		Expect(objects).To(HaveLen(3))
+		Expect(objects[0].Kind()).To(Equal("Namespace"))
+		Expect(objects[0].Name()).To(Equal("razeedeploy"))
+		Expect(objects[0].Namespace()).To(BeEmpty())
+		Expect(objects[1].APIVersion()).To(Equal("v1"))
+		Expect(objects[1].Kind()).To(Equal("Secret"))
+		Expect(objects[1].Namespace()).To(Equal("razeedeploy"))
+		Expect(objects[2].APIVersion()).To(Equal("batch/v1"))
+		Expect(objects[2]["spec"]).To(BeAssignableToTypeOf(map[string]interface{}{}))
+		Expect(objects[2]["spec"]).To(HaveKey("template"))
+	})
+
+	It("Errors when the URL is empty", func() {
+		_, err := RegistrationManifest(h, "")
+		Expect(err).To(HaveOccurred())
+		Expect(h.DoCallCount()).To(Equal(0))
+	})
+
+	Context("When the download fails", func() {
+		BeforeEach(func() {
+			h.DoReturns(nil, errors.New("Kablooie!"))
+		})
+
+		It("Bubbles up the error", func() {
+			_, err := RegistrationManifest(h, url)
+			Expect(err).To(MatchError("Kablooie!"))
+		})
+	})
+
+	Context("When the registration URL is no longer enabled", func() {
+		BeforeEach(func() {
+			response.StatusCode = http.StatusForbidden
+		})
+
+		It("Returns an error with the status", func() {
+			_, err := RegistrationManifest(h, url)
+			Expect(err).To(MatchError(ContainSubstring("403 Forbidden")))
+		})
+	})
+})
+
+var _ = Describe("ParseManifest", func() {
+	It("Returns nothing for an empty manifest", func() {
+		objects, err := ParseManifest([]byte(""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("Decodes values as JSON would, so numbers are float64", func() {
+		objects, err := ParseManifest([]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: watch-keeper\nspec:\n  replicas: 1\n  template:\n    spec:\n      containers:\n      - name: watch-keeper\n        ports:\n        - containerPort: 8080\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(1))
+
+		spec := objects[0]["spec"].(map[string]interface{})
+		Expect(spec["replicas"]).To(Equal(float64(1)))
+
+		containers := spec["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+		ports := containers[0].(map[string]interface{})["ports"].([]interface{})
+		Expect(ports[0]).To(Equal(map[string]interface{}{"containerPort": float64(8080)}))
+	})
+
+	It("Errors on invalid YAML", func() {
+		_, err := ParseManifest([]byte("apiVersion: v1\nkind: [Namespace\n"))
+		Expect(err).To(MatchError(ContainSubstring("document 1")))
+	})
+
+	It("Errors on documents which are not Kubernetes objects", func() {
+		_, err := ParseManifest([]byte("apiVersion: v1\nkind: Namespace\n---\nfoo: bar\n"))
+		Expect(err).To(MatchError("Manifest document 2 is not a Kubernetes object"))
+	})
+})
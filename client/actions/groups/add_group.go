@@ -2,6 +2,7 @@ package groups
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -38,34 +39,12 @@ func NewAddGroupVariables(orgID, name string) AddGroupVariables {
 	return vars
 }
 
-// AddGroupResponse is the response body we get upon a successful cluster
-// registration.
-type AddGroupResponse struct {
-	Data *AddGroupResponseData `json:"data,omitempty"`
-}
-
-type AddGroupResponseData struct {
-	Details *AddGroupResponseDataDetails `json:"addGroup,omitempty"`
-}
-
 type AddGroupResponseDataDetails struct {
 	UUID string `json:"uuid,omitempty"`
 }
 
 func (c *Client) AddGroup(orgID, name string) (*AddGroupResponseDataDetails, error) {
-	var response AddGroupResponse
-
 	vars := NewAddGroupVariables(orgID, name)
 
-	err := c.DoQuery(AddGroupVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*AddGroupResponseDataDetails](&c.SatConClient, QueryAddGroup, AddGroupVarTemplate, vars, nil)
 }
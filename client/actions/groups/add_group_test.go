@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -60,19 +61,15 @@ var _ = Describe("Adding a Group", func() {
 
 	Describe("AddGroup", func() {
 		var (
-			agResponse AddGroupResponse
+			agResponse *AddGroupResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			agResponse = AddGroupResponse{
-				Data: &AddGroupResponseData{
-					Details: &AddGroupResponseDataDetails{
-						UUID: "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
-					},
-				},
+			agResponse = &AddGroupResponseDataDetails{
+				UUID: "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
 			}
 
-			respBodyBytes, err := json.Marshal(agResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddGroup: agResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -88,7 +85,7 @@ var _ = Describe("Adding a Group", func() {
 			details, _ := c.AddGroup(orgID, name)
 			Expect(details).NotTo(BeNil())
 
-			expected := agResponse.Data.Details
+			expected := agResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -105,13 +102,13 @@ var _ = Describe("Adding a Group", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(AddGroupResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.AddGroup(orgID, name)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -0,0 +1,60 @@
+package groups
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	AddGroupWithOptionsVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"name":{{json .Name}},"tags":{{json .Tags}},"custom":{{json .Custom}}{{end}}`
+)
+
+// AddGroupOptions are the optional settings of a new group
+type AddGroupOptions struct {
+	types.Attributes
+}
+
+// AddGroupWithOptionsVariables are the variables specific to adding a group with
+// options.  These include the organization ID, the group name and its options.
+// Rather than instantiating this directly, use NewAddGroupWithOptionsVariables().
+type AddGroupWithOptionsVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	Name  string
+	AddGroupOptions
+}
+
+// NewAddGroupWithOptionsVariables creates a correctly formed instance of AddGroupWithOptionsVariables.
+func NewAddGroupWithOptionsVariables(orgID, name string, opts AddGroupOptions) AddGroupWithOptionsVariables {
+	vars := AddGroupWithOptionsVariables{
+		OrgID:           orgID,
+		Name:            name,
+		AddGroupOptions: opts,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryAddGroup
+	vars.Args = map[string]string{
+		"orgId":  "String!",
+		"name":   "String!",
+		"tags":   "[String!]",
+		"custom": "JSON",
+	}
+	vars.Returns = []string{
+		"uuid",
+	}
+
+	return vars
+}
+
+// AddGroupWithOptions adds a group with the specified tags and custom fields.
+func (c *Client) AddGroupWithOptions(orgID, name string, opts AddGroupOptions) (*AddGroupResponseDataDetails, error) {
+	if err := opts.Attributes.Validate(); err != nil {
+		return nil, err
+	}
+
+	vars := NewAddGroupWithOptionsVariables(orgID, name, opts)
+
+	return web.Do[*AddGroupResponseDataDetails](&c.SatConClient, QueryAddGroup, AddGroupWithOptionsVarTemplate, vars, nil)
+}
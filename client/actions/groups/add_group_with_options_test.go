@@ -0,0 +1,139 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("AddGroupWithOptions", func() {
+	var (
+		orgID, name    string
+		attrs          types.Attributes
+		c              GroupService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		name = "somegroup"
+		attrs = types.Attributes{
+			Tags:   []string{"team-edge", "prod"},
+			Custom: map[string]interface{}{"owner": "edge@example.com"},
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewAddGroupWithOptionsVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewAddGroupWithOptionsVariables(orgID, name, AddGroupOptions{Attributes: attrs})
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryAddGroup))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Name).To(Equal(name))
+			Expect(vars.Tags).To(Equal(attrs.Tags))
+			Expect(vars.Custom).To(Equal(attrs.Custom))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":  "String!",
+				"name":   "String!",
+				"tags":   "[String!]",
+				"custom": "JSON",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+			))
+		})
+	})
+
+	Describe("AddGroupWithOptions", func() {
+		var (
+			details *AddGroupResponseDataDetails
+		)
+
+		BeforeEach(func() {
+			details = &AddGroupResponseDataDetails{
+				UUID: "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddGroup: details}})
+			Expect(err).NotTo(HaveOccurred())
+
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the tags and custom fields", func() {
+			_, err := c.AddGroupWithOptions(orgID, name, AddGroupOptions{Attributes: attrs})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":  orgID,
+				"name":   name,
+				"tags":   []interface{}{"team-edge", "prod"},
+				"custom": map[string]interface{}{"owner": "edge@example.com"},
+			}))
+		})
+
+		It("Returns the add group details", func() {
+			result, _ := c.AddGroupWithOptions(orgID, name, AddGroupOptions{Attributes: attrs})
+			Expect(result).To(Equal(details))
+		})
+
+		It("Rejects invalid attributes without sending a request", func() {
+			attrs.Tags = []string{""}
+			_, err := c.AddGroupWithOptions(orgID, name, AddGroupOptions{Attributes: attrs})
+			Expect(err).To(MatchError("Tags must not be empty"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.AddGroupWithOptions(orgID, name, AddGroupOptions{Attributes: attrs})
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				result, err := c.AddGroupWithOptions(orgID, name, AddGroupOptions{Attributes: attrs})
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package groups
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryAssignClusterGroups       = "assignClusterGroups"
+	AssignClusterGroupsVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"groupUuids":[{{range $i,$e := .GroupUUIDs}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}],"clusterIds":[{{range $i,$e := .ClusterIDs}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}]{{end}}`
+)
+
+// AssignClusterGroupsVariables are the variables specific to adding clusters to groups.
+// These include the organization ID, group UUIDs, and cluster IDs.  Rather than
+// instantiating this directly, use NewAssignClusterGroupsVariables().
+type AssignClusterGroupsVariables struct {
+	actions.GraphQLQuery
+	OrgID      string
+	GroupUUIDs []string
+	ClusterIDs []string
+}
+
+// NewAssignClusterGroupsVariables creates a correctly formed instance of AssignClusterGroupsVariables.
+func NewAssignClusterGroupsVariables(orgID string, groupUUIDs, clusterIDs []string) AssignClusterGroupsVariables {
+	vars := AssignClusterGroupsVariables{
+		OrgID:      orgID,
+		GroupUUIDs: groupUUIDs,
+		ClusterIDs: clusterIDs,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryAssignClusterGroups
+	vars.Args = map[string]string{
+		"orgId":      "String!",
+		"groupUuids": "[String!]!",
+		"clusterIds": "[String!]!",
+	}
+	vars.Returns = []string{
+		"modified",
+	}
+
+	return vars
+}
+
+type AssignClusterGroupsResponseDataDetails struct {
+	Modified int `json:"modified,omitempty"`
+}
+
+// AssignClusterGroups adds every cluster to every group.  Clusters already in a group are left alone.
+func (c *Client) AssignClusterGroups(orgID string, groupUUIDs, clusterIDs []string) (*AssignClusterGroupsResponseDataDetails, error) {
+	vars := NewAssignClusterGroupsVariables(orgID, groupUUIDs, clusterIDs)
+
+	return web.Do[*AssignClusterGroupsResponseDataDetails](&c.SatConClient, QueryAssignClusterGroups, AssignClusterGroupsVarTemplate, vars, nil)
+}
@@ -0,0 +1,55 @@
+package groups
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEditClusterGroups       = "editClusterGroups"
+	EditClusterGroupsVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"clusterId":{{json .ClusterID}},"groupUuids":[{{range $i,$e := .GroupUUIDs}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}]{{end}}`
+)
+
+// EditClusterGroupsVariables are the variables specific to setting the groups of a cluster.
+// These include the organization ID, cluster ID, and group UUIDs.  Rather than
+// instantiating this directly, use NewEditClusterGroupsVariables().
+type EditClusterGroupsVariables struct {
+	actions.GraphQLQuery
+	OrgID      string
+	ClusterID  string
+	GroupUUIDs []string
+}
+
+// NewEditClusterGroupsVariables creates a correctly formed instance of EditClusterGroupsVariables.
+func NewEditClusterGroupsVariables(orgID, clusterID string, groupUUIDs []string) EditClusterGroupsVariables {
+	vars := EditClusterGroupsVariables{
+		OrgID:      orgID,
+		ClusterID:  clusterID,
+		GroupUUIDs: groupUUIDs,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEditClusterGroups
+	vars.Args = map[string]string{
+		"orgId":      "String!",
+		"clusterId":  "String!",
+		"groupUuids": "[String!]!",
+	}
+	vars.Returns = []string{
+		"modified",
+	}
+
+	return vars
+}
+
+type EditClusterGroupsResponseDataDetails struct {
+	Modified int `json:"modified,omitempty"`
+}
+
+// EditClusterGroups replaces the group membership of a cluster, so that it belongs
+// to exactly the specified groups.  An empty list removes it from every group.
+func (c *Client) EditClusterGroups(orgID, clusterID string, groupUUIDs []string) (*EditClusterGroupsResponseDataDetails, error) {
+	vars := NewEditClusterGroupsVariables(orgID, clusterID, groupUUIDs)
+
+	return web.Do[*EditClusterGroupsResponseDataDetails](&c.SatConClient, QueryEditClusterGroups, EditClusterGroupsVarTemplate, vars, nil)
+}
@@ -0,0 +1,134 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("EditClusterGroups", func() {
+	var (
+		orgID          string
+		clusterID      string
+		groupUUIDs     []string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		clusterID = "cluster1"
+		groupUUIDs = []string{"group1", "group2"}
+	})
+
+	Describe("NewEditClusterGroupsVariables", func() {
+		It("Returns a correctly populated instance of EditClusterGroupsVariables", func() {
+			vars := NewEditClusterGroupsVariables(orgID, clusterID, groupUUIDs)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryEditClusterGroups))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.ClusterID).To(Equal(clusterID))
+			Expect(vars.GroupUUIDs).To(Equal(groupUUIDs))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":      "String!",
+				"clusterId":  "String!",
+				"groupUuids": "[String!]!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"modified",
+			))
+		})
+	})
+
+	Describe("EditClusterGroups", func() {
+		var (
+			c        GroupService
+			h        *webfakes.FakeHTTPClient
+			response *http.Response
+			details  *EditClusterGroupsResponseDataDetails
+		)
+
+		BeforeEach(func() {
+			details = &EditClusterGroupsResponseDataDetails{
+				Modified: 6,
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryEditClusterGroups: details}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Sends the cluster ID and every group UUID", func() {
+			_, err := c.EditClusterGroups(orgID, clusterID, groupUUIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":      orgID,
+				"clusterId":  clusterID,
+				"groupUuids": []interface{}{"group1", "group2"},
+			}))
+		})
+
+		It("Sends an empty list to remove the cluster from every group", func() {
+			_, err := c.EditClusterGroups(orgID, clusterID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables["groupUuids"]).To(Equal([]interface{}{}))
+		})
+
+		It("Returns the response details", func() {
+			result, _ := c.EditClusterGroups(orgID, clusterID, groupUUIDs)
+			Expect(result).To(Equal(details))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.EditClusterGroups(orgID, clusterID, groupUUIDs)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				result, err := c.EditClusterGroups(orgID, clusterID, groupUUIDs)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEditGroup       = "editGroup"
+	EditGroupVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}},"name":{{json .Name}}{{with .Attributes}},"tags":{{json .Tags}},"custom":{{json .Custom}}{{end}}{{end}}`
+)
+
+// EditGroupOptions are the changes EditGroup makes to a group
+type EditGroupOptions struct {
+	// Name is the group's name, which is always set, so pass the current name to keep it
+	Name string
+	// Attributes, if set, replace the group's tags and custom fields, and empty
+	// attributes clear them.  If nil, the tags and custom fields are not sent.
+	Attributes *types.Attributes
+}
+
+// EditGroupVariables are the variables specific to editing a group.
+// These include the organization ID, the group UUID and the changes.  Rather
+// than instantiating this directly, use NewEditGroupVariables().
+type EditGroupVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	UUID  string
+	EditGroupOptions
+}
+
+// NewEditGroupVariables creates a correctly formed instance of EditGroupVariables.
+func NewEditGroupVariables(orgID, uuid string, opts EditGroupOptions) EditGroupVariables {
+	if opts.Attributes != nil {
+		// Empty tags and custom fields are sent as [] and {} to clear them
+		explicit := opts.Attributes.Explicit()
+		opts.Attributes = &explicit
+	}
+
+	vars := EditGroupVariables{
+		OrgID:            orgID,
+		UUID:             uuid,
+		EditGroupOptions: opts,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEditGroup
+	vars.Args = map[string]string{
+		"orgId":  "String!",
+		"uuid":   "String!",
+		"name":   "String!",
+		"tags":   "[String!]",
+		"custom": "JSON",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"success",
+	}
+
+	return vars
+}
+
+type EditGroupResponseDataDetails struct {
+	UUID    string `json:"uuid,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// EditGroup edits the group in place and returns the updated group, which keeps
+// its UUID and clusters.
+func (c *Client) EditGroup(orgID, uuid string, opts EditGroupOptions) (*types.Group, error) {
+	if opts.Name == "" {
+		return nil, errors.New("Must supply a group name")
+	}
+	if opts.Attributes != nil {
+		if err := opts.Attributes.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	vars := NewEditGroupVariables(orgID, uuid, opts)
+
+	details, err := web.Do[*EditGroupResponseDataDetails](&c.SatConClient, QueryEditGroup, EditGroupVarTemplate, vars, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !details.Success {
+		return nil, fmt.Errorf("Unable to edit group %s", uuid)
+	}
+
+	return c.Group(orgID, uuid)
+}
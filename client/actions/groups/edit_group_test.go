@@ -0,0 +1,219 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("EditGroup", func() {
+	var (
+		orgID          string
+		uuid           string
+		name           string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		uuid = "group-uuid"
+		name = "renamed"
+	})
+
+	Describe("NewEditGroupVariables", func() {
+		It("Returns a correctly populated instance of EditGroupVariables", func() {
+			vars := NewEditGroupVariables(orgID, uuid, EditGroupOptions{Name: name})
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryEditGroup))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(uuid))
+			Expect(vars.Name).To(Equal(name))
+			Expect(vars.Attributes).To(BeNil())
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":  "String!",
+				"uuid":   "String!",
+				"name":   "String!",
+				"tags":   "[String!]",
+				"custom": "JSON",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"success",
+			))
+		})
+
+		It("Sends empty attributes explicitly so they clear the existing ones", func() {
+			vars := NewEditGroupVariables(orgID, uuid, EditGroupOptions{Name: name, Attributes: &types.Attributes{}})
+			Expect(vars.Attributes.Tags).To(Equal([]string{}))
+			Expect(vars.Attributes.Custom).To(Equal(map[string]interface{}{}))
+		})
+	})
+
+	Describe("EditGroup", func() {
+		var (
+			c       GroupService
+			h       *webfakes.FakeHTTPClient
+			details *EditGroupResponseDataDetails
+			entity  *types.Group
+			opts    EditGroupOptions
+		)
+
+		respond := func(query string, value interface{}) *http.Response {
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{query: value}})
+			Expect(err).NotTo(HaveOccurred())
+			return &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+		}
+
+		BeforeEach(func() {
+			details = &EditGroupResponseDataDetails{
+				UUID:    uuid,
+				Success: true,
+			}
+			entity = &types.Group{
+				UUID:  types.GroupUUID(uuid),
+				OrgID: types.OrgID(orgID),
+				Name:  name,
+				Clusters: []types.Cluster{
+					{ClusterID: "cluster1", Name: "cluster1"},
+				},
+			}
+
+			opts = EditGroupOptions{Name: name}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturnsOnCall(0, respond(QueryEditGroup, details), nil)
+			h.DoReturnsOnCall(1, respond(QueryGroup, entity), nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Sends the edit and then fetches the group", func() {
+			_, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(2))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId": orgID,
+				"uuid":  uuid,
+				"name":  name,
+			}))
+		})
+
+		It("Sends the tags and custom fields", func() {
+			opts.Attributes = &types.Attributes{
+				Tags:   []string{"prod"},
+				Custom: map[string]interface{}{"owner": "edge@example.com"},
+			}
+			_, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("tags", []interface{}{"prod"}))
+			Expect(body.Variables).To(HaveKeyWithValue("custom", map[string]interface{}{"owner": "edge@example.com"}))
+		})
+
+		It("Clears the tags and custom fields when the attributes are empty", func() {
+			opts.Attributes = &types.Attributes{}
+			_, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("tags", []interface{}{}))
+			Expect(body.Variables).To(HaveKeyWithValue("custom", map[string]interface{}{}))
+		})
+
+		It("Sends the editGroup mutation", func() {
+			_, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Query string `json:"query"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Query).To(HavePrefix("mutation "))
+			Expect(body.Query).To(ContainSubstring("\n  editGroup("))
+		})
+
+		It("Requires a name", func() {
+			opts.Name = ""
+			_, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).To(MatchError("Must supply a group name"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Rejects invalid attributes without sending a request", func() {
+			opts.Attributes = &types.Attributes{Tags: []string{""}}
+			_, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).To(MatchError("Tags must not be empty"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Returns the updated group", func() {
+			result, err := c.EditGroup(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(entity))
+		})
+
+		Context("When the edit is not successful", func() {
+			BeforeEach(func() {
+				details.Success = false
+				h.DoReturnsOnCall(0, respond(QueryEditGroup, details), nil)
+			})
+
+			It("Returns an error without fetching the group", func() {
+				result, err := c.EditGroup(orgID, uuid, opts)
+				Expect(err).To(MatchError("Unable to edit group group-uuid"))
+				Expect(result).To(BeNil())
+				Expect(h.DoCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturnsOnCall(0, nil, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.EditGroup(orgID, uuid, opts)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				h.DoReturnsOnCall(0, &http.Response{Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes))}, nil)
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				result, err := c.EditGroup(orgID, uuid, opts)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
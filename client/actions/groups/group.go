@@ -0,0 +1,50 @@
+package groups
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryGroup       = "group"
+	GroupVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}}{{end}}`
+)
+
+type GroupVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	UUID  string
+}
+
+func NewGroupVariables(orgID string, uuid string) GroupVariables {
+	vars := GroupVariables{
+		OrgID: orgID,
+		UUID:  uuid,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryGroup
+	vars.Args = map[string]string{
+		"orgId": "String!",
+		"uuid":  "String!",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"orgId",
+		"name",
+		"tags",
+		"custom",
+		"created",
+		"clusters{id,orgId,clusterId,name,metadata}",
+	}
+
+	return vars
+}
+
+// Group returns the group with the specified UUID
+func (c *Client) Group(orgID string, uuid string) (*types.Group, error) {
+	vars := NewGroupVariables(orgID, uuid)
+
+	return web.Do[*types.Group](&c.SatConClient, QueryGroup, GroupVarTemplate, vars, nil)
+}
@@ -3,6 +3,7 @@ package groups
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -32,6 +33,8 @@ func NewGroupByNameVariables(orgID string, name string) GroupByNameVariables {
 		"uuid",
 		"orgId",
 		"name",
+		"tags",
+		"custom",
 		"created",
 		"clusters{id,orgId,clusterId,name,metadata}",
 	}
@@ -39,28 +42,8 @@ func NewGroupByNameVariables(orgID string, name string) GroupByNameVariables {
 	return vars
 }
 
-type GroupByNameResponse struct {
-	Data *GroupByNameResponseData `json:"data,omitempty"`
-}
-
-type GroupByNameResponseData struct {
-	Group *types.Group `json:"groupByName,omitempty"`
-}
-
 func (c *Client) GroupByName(orgID string, name string) (*types.Group, error) {
-	var response GroupByNameResponse
-
 	vars := NewGroupByNameVariables(orgID, name)
 
-	err := c.DoQuery(GroupByNameVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Group, err
-	}
-
-	return nil, err
+	return web.Do[*types.Group](&c.SatConClient, QueryGroupByName, GroupByNameVarTemplate, vars, nil)
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -44,6 +45,8 @@ var _ = Describe("GroupByName", func() {
 				"uuid",
 				"orgId",
 				"name",
+				"tags",
+				"custom",
 				"created",
 				"clusters{id,orgId,clusterId,name,metadata}",
 			))
@@ -55,29 +58,25 @@ var _ = Describe("GroupByName", func() {
 			c             GroupService
 			h             *webfakes.FakeHTTPClient
 			response      *http.Response
-			groupResponse GroupByNameResponse
+			groupResponse *types.Group
 		)
 
 		BeforeEach(func() {
-			groupResponse = GroupByNameResponse{
-				Data: &GroupByNameResponseData{
-					&types.Group{
-						UUID:  "asdf",
-						OrgID: orgID,
-						Name:  "group1",
-						Clusters: []types.Cluster{
-							{
-								ID:        "cid",
-								OrgID:     "oid",
-								ClusterID: "cid",
-								Name:      "cluster1",
-							},
-						},
+			groupResponse = &types.Group{
+				UUID:  "asdf",
+				OrgID: types.OrgID(orgID),
+				Name:  "group1",
+				Clusters: []types.Cluster{
+					{
+						ID:        "cid",
+						OrgID:     "oid",
+						ClusterID: "cid",
+						Name:      "cluster1",
 					},
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(groupResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryGroupByName: groupResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -98,7 +97,7 @@ var _ = Describe("GroupByName", func() {
 
 		It("Returns the group", func() {
 			groups, _ := c.GroupByName(orgID, groupName)
-			expected := groupResponse.Data.Group
+			expected := groupResponse
 			Expect(groups).To(Equal(expected))
 		})
 
@@ -115,13 +114,13 @@ var _ = Describe("GroupByName", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(GroupsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				groups, err := c.GroupByName(orgID, groupName)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(groups).To(BeNil())
 			})
 		})
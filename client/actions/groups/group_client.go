@@ -20,6 +20,13 @@ type GroupService interface {
 	RemoveGroupByName(orgID, name string) (*RemoveGroupByNameResponseDataDetails, error)
 	GroupClusters(orgID, uuid string, clusters []string) (*GroupClustersResponseDataDetails, error)
 	UnGroupClusters(orgID, uuid string, clusters []string) (*UnGroupClustersResponseDataDetails, error)
+	Group(orgID, uuid string) (*types.Group, error)
+	AssignClusterGroups(orgID string, groupUUIDs, clusterIDs []string) (*AssignClusterGroupsResponseDataDetails, error)
+	UnassignClusterGroups(orgID string, groupUUIDs, clusterIDs []string) (*UnassignClusterGroupsResponseDataDetails, error)
+	EditClusterGroups(orgID, clusterID string, groupUUIDs []string) (*EditClusterGroupsResponseDataDetails, error)
+	EditGroup(orgID, uuid string, opts EditGroupOptions) (*types.Group, error)
+	AddGroupWithOptions(orgID, name string, opts AddGroupOptions) (*AddGroupResponseDataDetails, error)
+	GroupsByTags(orgID string, tags []string) (types.GroupList, error)
 }
 
 // Client is an implementation of a satcon client.
@@ -2,6 +2,7 @@ package groups
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -41,34 +42,12 @@ func NewGroupClustersVariables(orgID, uuid string, clusters []string) GroupClust
 	return vars
 }
 
-// GroupClustersResponse is the response body we get upon a successful cluster
-// registration.
-type GroupClustersResponse struct {
-	Data *GroupClustersResponseData `json:"data,omitempty"`
-}
-
-type GroupClustersResponseData struct {
-	Details *GroupClustersResponseDataDetails `json:"groupClusters,omitempty"`
-}
-
 type GroupClustersResponseDataDetails struct {
 	Modified int `json:"modified,omitempty"`
 }
 
 func (c *Client) GroupClusters(orgID, uuid string, clusters []string) (*GroupClustersResponseDataDetails, error) {
-	var response GroupClustersResponse
-
 	vars := NewGroupClustersVariables(orgID, uuid, clusters)
 
-	err := c.DoQuery(GroupClustersVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*GroupClustersResponseDataDetails](&c.SatConClient, QueryGroupClusters, GroupClustersVarTemplate, vars, nil)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -79,19 +80,15 @@ var _ = Describe("GroupClusters", func() {
 			c                     GroupService
 			h                     *webfakes.FakeHTTPClient
 			response              *http.Response
-			groupClustersResponse GroupClustersResponse
+			groupClustersResponse *GroupClustersResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			groupClustersResponse = GroupClustersResponse{
-				Data: &GroupClustersResponseData{
-					Details: &GroupClustersResponseDataDetails{
-						Modified: 5,
-					},
-				},
+			groupClustersResponse = &GroupClustersResponseDataDetails{
+				Modified: 5,
 			}
 
-			respBodyBytes, err := json.Marshal(groupClustersResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryGroupClusters: groupClustersResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response = &http.Response{
@@ -112,7 +109,7 @@ var _ = Describe("GroupClusters", func() {
 		It("Returns the response details", func() {
 			details, _ := c.GroupClusters(orgID, uuid, clusters)
 			Expect(details).NotTo(BeNil())
-			expected := groupClustersResponse.Data.Details
+			expected := groupClustersResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -129,13 +126,13 @@ var _ = Describe("GroupClusters", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(GroupClustersResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.GroupClusters(orgID, uuid, clusters)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
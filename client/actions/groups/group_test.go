@@ -0,0 +1,128 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Group", func() {
+	var (
+		orgID          string
+		uuid           string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		uuid = "asdf"
+	})
+
+	Describe("NewGroupVariables", func() {
+		It("Returns a correctly populated instance of GroupVariables", func() {
+			vars := NewGroupVariables(orgID, uuid)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QueryGroup))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(uuid))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId": "String!",
+				"uuid":  "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"orgId",
+				"name",
+				"tags",
+				"custom",
+				"created",
+				"clusters{id,orgId,clusterId,name,metadata}",
+			))
+		})
+	})
+
+	Describe("Group", func() {
+		var (
+			c             GroupService
+			h             *webfakes.FakeHTTPClient
+			response      *http.Response
+			groupResponse *types.Group
+		)
+
+		BeforeEach(func() {
+			groupResponse = &types.Group{
+				UUID:  "asdf",
+				OrgID: types.OrgID(orgID),
+				Name:  "group1",
+				Clusters: []types.Cluster{
+					{
+						ID:        "cid",
+						OrgID:     "oid",
+						ClusterID: "cid",
+						Name:      "cluster1",
+					},
+				},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryGroup: groupResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			Expect(h.DoCallCount()).To(Equal(0))
+			h.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.Group(orgID, uuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the group", func() {
+			groups, _ := c.Group(orgID, uuid)
+			expected := groupResponse
+			Expect(groups).To(Equal(expected))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.Group(orgID, uuid)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				groups, err := c.Group(orgID, uuid)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(groups).To(BeNil())
+			})
+		})
+	})
+})
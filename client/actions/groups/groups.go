@@ -3,6 +3,7 @@ package groups
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -29,6 +30,8 @@ func NewGroupsVariables(orgID string) GroupsVariables {
 		"uuid",
 		"orgId",
 		"name",
+		"tags",
+		"custom",
 		"created",
 		"clusters{id,orgId,clusterId,name,metadata}",
 	}
@@ -36,28 +39,8 @@ func NewGroupsVariables(orgID string) GroupsVariables {
 	return vars
 }
 
-type GroupsResponse struct {
-	Data *GroupsResponseData `json:"data,omitempty"`
-}
-
-type GroupsResponseData struct {
-	Groups types.GroupList `json:"groups,omitempty"`
-}
-
 func (c *Client) Groups(orgID string) (types.GroupList, error) {
-	var response GroupsResponse
-
 	vars := NewGroupsVariables(orgID)
 
-	err := c.DoQuery(GroupsVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Groups, err
-	}
-
-	return nil, err
+	return web.Do[types.GroupList](&c.SatConClient, QueryGroups, GroupsVarTemplate, vars, nil)
 }
@@ -0,0 +1,23 @@
+package groups
+
+import (
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// GroupsByTags returns the groups of the organization which have every one of tags.
+// The filtering is done client side, after listing all of the organization's groups.
+func (c *Client) GroupsByTags(orgID string, tags []string) (types.GroupList, error) {
+	list, err := c.Groups(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagged types.GroupList
+	for _, item := range list {
+		if item.HasTags(tags...) {
+			tagged = append(tagged, item)
+		}
+	}
+
+	return tagged, nil
+}
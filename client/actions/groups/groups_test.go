@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -40,6 +41,8 @@ var _ = Describe("Groups", func() {
 				"uuid",
 				"orgId",
 				"name",
+				"tags",
+				"custom",
 				"created",
 				"clusters{id,orgId,clusterId,name,metadata}",
 			))
@@ -51,41 +54,37 @@ var _ = Describe("Groups", func() {
 			c              GroupService
 			h              *webfakes.FakeHTTPClient
 			response       *http.Response
-			groupsResponse GroupsResponse
+			groupsResponse types.GroupList
 		)
 
 		BeforeEach(func() {
-			groupsResponse = GroupsResponse{
-				Data: &GroupsResponseData{
-					Groups: types.GroupList{
+			groupsResponse = types.GroupList{
+				{
+					UUID:  "asdf",
+					OrgID: types.OrgID(orgID),
+					Name:  "cluster1",
+					Clusters: []types.Cluster{
 						{
-							UUID:  "asdf",
-							OrgID: orgID,
-							Name:  "cluster1",
-							Clusters: []types.Cluster{
-								{
-									ID:        "cid",
-									OrgID:     "oid",
-									ClusterID: "cid",
-									Name:      "cluster1",
-								},
-							},
-						},
-						{
-							UUID:  "qwer",
-							OrgID: orgID,
-							Name:  "cluster2",
-						},
-						{
-							UUID:  "xzcv",
-							OrgID: orgID,
-							Name:  "cluster3",
+							ID:        "cid",
+							OrgID:     "oid",
+							ClusterID: "cid",
+							Name:      "cluster1",
 						},
 					},
 				},
+				{
+					UUID:  "qwer",
+					OrgID: types.OrgID(orgID),
+					Name:  "cluster2",
+				},
+				{
+					UUID:  "xzcv",
+					OrgID: types.OrgID(orgID),
+					Name:  "cluster3",
+				},
 			}
 
-			respBodyBytes, err := json.Marshal(groupsResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryGroups: groupsResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -106,7 +105,7 @@ var _ = Describe("Groups", func() {
 
 		It("Returns the list of clusters", func() {
 			groups, _ := c.Groups(orgID)
-			expected := groupsResponse.Data.Groups
+			expected := groupsResponse
 			Expect(groups).To(Equal(expected))
 		})
 
@@ -123,13 +122,13 @@ var _ = Describe("Groups", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(GroupsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				groups, err := c.Groups(orgID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(groups).To(BeNil())
 			})
 		})
@@ -23,6 +23,80 @@ type FakeGroupService struct {
 		result1 *groups.AddGroupResponseDataDetails
 		result2 error
 	}
+	AddGroupWithOptionsStub        func(string, string, groups.AddGroupOptions) (*groups.AddGroupResponseDataDetails, error)
+	addGroupWithOptionsMutex       sync.RWMutex
+	addGroupWithOptionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 groups.AddGroupOptions
+	}
+	addGroupWithOptionsReturns struct {
+		result1 *groups.AddGroupResponseDataDetails
+		result2 error
+	}
+	addGroupWithOptionsReturnsOnCall map[int]struct {
+		result1 *groups.AddGroupResponseDataDetails
+		result2 error
+	}
+	AssignClusterGroupsStub        func(string, []string, []string) (*groups.AssignClusterGroupsResponseDataDetails, error)
+	assignClusterGroupsMutex       sync.RWMutex
+	assignClusterGroupsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 []string
+	}
+	assignClusterGroupsReturns struct {
+		result1 *groups.AssignClusterGroupsResponseDataDetails
+		result2 error
+	}
+	assignClusterGroupsReturnsOnCall map[int]struct {
+		result1 *groups.AssignClusterGroupsResponseDataDetails
+		result2 error
+	}
+	EditClusterGroupsStub        func(string, string, []string) (*groups.EditClusterGroupsResponseDataDetails, error)
+	editClusterGroupsMutex       sync.RWMutex
+	editClusterGroupsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	editClusterGroupsReturns struct {
+		result1 *groups.EditClusterGroupsResponseDataDetails
+		result2 error
+	}
+	editClusterGroupsReturnsOnCall map[int]struct {
+		result1 *groups.EditClusterGroupsResponseDataDetails
+		result2 error
+	}
+	EditGroupStub        func(string, string, groups.EditGroupOptions) (*types.Group, error)
+	editGroupMutex       sync.RWMutex
+	editGroupArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 groups.EditGroupOptions
+	}
+	editGroupReturns struct {
+		result1 *types.Group
+		result2 error
+	}
+	editGroupReturnsOnCall map[int]struct {
+		result1 *types.Group
+		result2 error
+	}
+	GroupStub        func(string, string) (*types.Group, error)
+	groupMutex       sync.RWMutex
+	groupArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	groupReturns struct {
+		result1 *types.Group
+		result2 error
+	}
+	groupReturnsOnCall map[int]struct {
+		result1 *types.Group
+		result2 error
+	}
 	GroupByNameStub        func(string, string) (*types.Group, error)
 	groupByNameMutex       sync.RWMutex
 	groupByNameArgsForCall []struct {
@@ -65,6 +139,20 @@ type FakeGroupService struct {
 		result1 types.GroupList
 		result2 error
 	}
+	GroupsByTagsStub        func(string, []string) (types.GroupList, error)
+	groupsByTagsMutex       sync.RWMutex
+	groupsByTagsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	groupsByTagsReturns struct {
+		result1 types.GroupList
+		result2 error
+	}
+	groupsByTagsReturnsOnCall map[int]struct {
+		result1 types.GroupList
+		result2 error
+	}
 	RemoveGroupStub        func(string, string) (*groups.RemoveGroupResponseDataDetails, error)
 	removeGroupMutex       sync.RWMutex
 	removeGroupArgsForCall []struct {
@@ -108,6 +196,21 @@ type FakeGroupService struct {
 		result1 *groups.UnGroupClustersResponseDataDetails
 		result2 error
 	}
+	UnassignClusterGroupsStub        func(string, []string, []string) (*groups.UnassignClusterGroupsResponseDataDetails, error)
+	unassignClusterGroupsMutex       sync.RWMutex
+	unassignClusterGroupsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 []string
+	}
+	unassignClusterGroupsReturns struct {
+		result1 *groups.UnassignClusterGroupsResponseDataDetails
+		result2 error
+	}
+	unassignClusterGroupsReturnsOnCall map[int]struct {
+		result1 *groups.UnassignClusterGroupsResponseDataDetails
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -177,6 +280,350 @@ func (fake *FakeGroupService) AddGroupReturnsOnCall(i int, result1 *groups.AddGr
 	}{result1, result2}
 }
 
+func (fake *FakeGroupService) AddGroupWithOptions(arg1 string, arg2 string, arg3 groups.AddGroupOptions) (*groups.AddGroupResponseDataDetails, error) {
+	fake.addGroupWithOptionsMutex.Lock()
+	ret, specificReturn := fake.addGroupWithOptionsReturnsOnCall[len(fake.addGroupWithOptionsArgsForCall)]
+	fake.addGroupWithOptionsArgsForCall = append(fake.addGroupWithOptionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 groups.AddGroupOptions
+	}{arg1, arg2, arg3})
+	stub := fake.AddGroupWithOptionsStub
+	fakeReturns := fake.addGroupWithOptionsReturns
+	fake.recordInvocation("AddGroupWithOptions", []interface{}{arg1, arg2, arg3})
+	fake.addGroupWithOptionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) AddGroupWithOptionsCallCount() int {
+	fake.addGroupWithOptionsMutex.RLock()
+	defer fake.addGroupWithOptionsMutex.RUnlock()
+	return len(fake.addGroupWithOptionsArgsForCall)
+}
+
+func (fake *FakeGroupService) AddGroupWithOptionsCalls(stub func(string, string, groups.AddGroupOptions) (*groups.AddGroupResponseDataDetails, error)) {
+	fake.addGroupWithOptionsMutex.Lock()
+	defer fake.addGroupWithOptionsMutex.Unlock()
+	fake.AddGroupWithOptionsStub = stub
+}
+
+func (fake *FakeGroupService) AddGroupWithOptionsArgsForCall(i int) (string, string, groups.AddGroupOptions) {
+	fake.addGroupWithOptionsMutex.RLock()
+	defer fake.addGroupWithOptionsMutex.RUnlock()
+	argsForCall := fake.addGroupWithOptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGroupService) AddGroupWithOptionsReturns(result1 *groups.AddGroupResponseDataDetails, result2 error) {
+	fake.addGroupWithOptionsMutex.Lock()
+	defer fake.addGroupWithOptionsMutex.Unlock()
+	fake.AddGroupWithOptionsStub = nil
+	fake.addGroupWithOptionsReturns = struct {
+		result1 *groups.AddGroupResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) AddGroupWithOptionsReturnsOnCall(i int, result1 *groups.AddGroupResponseDataDetails, result2 error) {
+	fake.addGroupWithOptionsMutex.Lock()
+	defer fake.addGroupWithOptionsMutex.Unlock()
+	fake.AddGroupWithOptionsStub = nil
+	if fake.addGroupWithOptionsReturnsOnCall == nil {
+		fake.addGroupWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 *groups.AddGroupResponseDataDetails
+			result2 error
+		})
+	}
+	fake.addGroupWithOptionsReturnsOnCall[i] = struct {
+		result1 *groups.AddGroupResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) AssignClusterGroups(arg1 string, arg2 []string, arg3 []string) (*groups.AssignClusterGroupsResponseDataDetails, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.assignClusterGroupsMutex.Lock()
+	ret, specificReturn := fake.assignClusterGroupsReturnsOnCall[len(fake.assignClusterGroupsArgsForCall)]
+	fake.assignClusterGroupsArgsForCall = append(fake.assignClusterGroupsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 []string
+	}{arg1, arg2Copy, arg3Copy})
+	stub := fake.AssignClusterGroupsStub
+	fakeReturns := fake.assignClusterGroupsReturns
+	fake.recordInvocation("AssignClusterGroups", []interface{}{arg1, arg2Copy, arg3Copy})
+	fake.assignClusterGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) AssignClusterGroupsCallCount() int {
+	fake.assignClusterGroupsMutex.RLock()
+	defer fake.assignClusterGroupsMutex.RUnlock()
+	return len(fake.assignClusterGroupsArgsForCall)
+}
+
+func (fake *FakeGroupService) AssignClusterGroupsCalls(stub func(string, []string, []string) (*groups.AssignClusterGroupsResponseDataDetails, error)) {
+	fake.assignClusterGroupsMutex.Lock()
+	defer fake.assignClusterGroupsMutex.Unlock()
+	fake.AssignClusterGroupsStub = stub
+}
+
+func (fake *FakeGroupService) AssignClusterGroupsArgsForCall(i int) (string, []string, []string) {
+	fake.assignClusterGroupsMutex.RLock()
+	defer fake.assignClusterGroupsMutex.RUnlock()
+	argsForCall := fake.assignClusterGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGroupService) AssignClusterGroupsReturns(result1 *groups.AssignClusterGroupsResponseDataDetails, result2 error) {
+	fake.assignClusterGroupsMutex.Lock()
+	defer fake.assignClusterGroupsMutex.Unlock()
+	fake.AssignClusterGroupsStub = nil
+	fake.assignClusterGroupsReturns = struct {
+		result1 *groups.AssignClusterGroupsResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) AssignClusterGroupsReturnsOnCall(i int, result1 *groups.AssignClusterGroupsResponseDataDetails, result2 error) {
+	fake.assignClusterGroupsMutex.Lock()
+	defer fake.assignClusterGroupsMutex.Unlock()
+	fake.AssignClusterGroupsStub = nil
+	if fake.assignClusterGroupsReturnsOnCall == nil {
+		fake.assignClusterGroupsReturnsOnCall = make(map[int]struct {
+			result1 *groups.AssignClusterGroupsResponseDataDetails
+			result2 error
+		})
+	}
+	fake.assignClusterGroupsReturnsOnCall[i] = struct {
+		result1 *groups.AssignClusterGroupsResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) EditClusterGroups(arg1 string, arg2 string, arg3 []string) (*groups.EditClusterGroupsResponseDataDetails, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.editClusterGroupsMutex.Lock()
+	ret, specificReturn := fake.editClusterGroupsReturnsOnCall[len(fake.editClusterGroupsArgsForCall)]
+	fake.editClusterGroupsArgsForCall = append(fake.editClusterGroupsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.EditClusterGroupsStub
+	fakeReturns := fake.editClusterGroupsReturns
+	fake.recordInvocation("EditClusterGroups", []interface{}{arg1, arg2, arg3Copy})
+	fake.editClusterGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) EditClusterGroupsCallCount() int {
+	fake.editClusterGroupsMutex.RLock()
+	defer fake.editClusterGroupsMutex.RUnlock()
+	return len(fake.editClusterGroupsArgsForCall)
+}
+
+func (fake *FakeGroupService) EditClusterGroupsCalls(stub func(string, string, []string) (*groups.EditClusterGroupsResponseDataDetails, error)) {
+	fake.editClusterGroupsMutex.Lock()
+	defer fake.editClusterGroupsMutex.Unlock()
+	fake.EditClusterGroupsStub = stub
+}
+
+func (fake *FakeGroupService) EditClusterGroupsArgsForCall(i int) (string, string, []string) {
+	fake.editClusterGroupsMutex.RLock()
+	defer fake.editClusterGroupsMutex.RUnlock()
+	argsForCall := fake.editClusterGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGroupService) EditClusterGroupsReturns(result1 *groups.EditClusterGroupsResponseDataDetails, result2 error) {
+	fake.editClusterGroupsMutex.Lock()
+	defer fake.editClusterGroupsMutex.Unlock()
+	fake.EditClusterGroupsStub = nil
+	fake.editClusterGroupsReturns = struct {
+		result1 *groups.EditClusterGroupsResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) EditClusterGroupsReturnsOnCall(i int, result1 *groups.EditClusterGroupsResponseDataDetails, result2 error) {
+	fake.editClusterGroupsMutex.Lock()
+	defer fake.editClusterGroupsMutex.Unlock()
+	fake.EditClusterGroupsStub = nil
+	if fake.editClusterGroupsReturnsOnCall == nil {
+		fake.editClusterGroupsReturnsOnCall = make(map[int]struct {
+			result1 *groups.EditClusterGroupsResponseDataDetails
+			result2 error
+		})
+	}
+	fake.editClusterGroupsReturnsOnCall[i] = struct {
+		result1 *groups.EditClusterGroupsResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) EditGroup(arg1 string, arg2 string, arg3 groups.EditGroupOptions) (*types.Group, error) {
+	fake.editGroupMutex.Lock()
+	ret, specificReturn := fake.editGroupReturnsOnCall[len(fake.editGroupArgsForCall)]
+	fake.editGroupArgsForCall = append(fake.editGroupArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 groups.EditGroupOptions
+	}{arg1, arg2, arg3})
+	stub := fake.EditGroupStub
+	fakeReturns := fake.editGroupReturns
+	fake.recordInvocation("EditGroup", []interface{}{arg1, arg2, arg3})
+	fake.editGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) EditGroupCallCount() int {
+	fake.editGroupMutex.RLock()
+	defer fake.editGroupMutex.RUnlock()
+	return len(fake.editGroupArgsForCall)
+}
+
+func (fake *FakeGroupService) EditGroupCalls(stub func(string, string, groups.EditGroupOptions) (*types.Group, error)) {
+	fake.editGroupMutex.Lock()
+	defer fake.editGroupMutex.Unlock()
+	fake.EditGroupStub = stub
+}
+
+func (fake *FakeGroupService) EditGroupArgsForCall(i int) (string, string, groups.EditGroupOptions) {
+	fake.editGroupMutex.RLock()
+	defer fake.editGroupMutex.RUnlock()
+	argsForCall := fake.editGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGroupService) EditGroupReturns(result1 *types.Group, result2 error) {
+	fake.editGroupMutex.Lock()
+	defer fake.editGroupMutex.Unlock()
+	fake.EditGroupStub = nil
+	fake.editGroupReturns = struct {
+		result1 *types.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) EditGroupReturnsOnCall(i int, result1 *types.Group, result2 error) {
+	fake.editGroupMutex.Lock()
+	defer fake.editGroupMutex.Unlock()
+	fake.EditGroupStub = nil
+	if fake.editGroupReturnsOnCall == nil {
+		fake.editGroupReturnsOnCall = make(map[int]struct {
+			result1 *types.Group
+			result2 error
+		})
+	}
+	fake.editGroupReturnsOnCall[i] = struct {
+		result1 *types.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) Group(arg1 string, arg2 string) (*types.Group, error) {
+	fake.groupMutex.Lock()
+	ret, specificReturn := fake.groupReturnsOnCall[len(fake.groupArgsForCall)]
+	fake.groupArgsForCall = append(fake.groupArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupStub
+	fakeReturns := fake.groupReturns
+	fake.recordInvocation("Group", []interface{}{arg1, arg2})
+	fake.groupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) GroupCallCount() int {
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	return len(fake.groupArgsForCall)
+}
+
+func (fake *FakeGroupService) GroupCalls(stub func(string, string) (*types.Group, error)) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = stub
+}
+
+func (fake *FakeGroupService) GroupArgsForCall(i int) (string, string) {
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	argsForCall := fake.groupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeGroupService) GroupReturns(result1 *types.Group, result2 error) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = nil
+	fake.groupReturns = struct {
+		result1 *types.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) GroupReturnsOnCall(i int, result1 *types.Group, result2 error) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = nil
+	if fake.groupReturnsOnCall == nil {
+		fake.groupReturnsOnCall = make(map[int]struct {
+			result1 *types.Group
+			result2 error
+		})
+	}
+	fake.groupReturnsOnCall[i] = struct {
+		result1 *types.Group
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeGroupService) GroupByName(arg1 string, arg2 string) (*types.Group, error) {
 	fake.groupByNameMutex.Lock()
 	ret, specificReturn := fake.groupByNameReturnsOnCall[len(fake.groupByNameArgsForCall)]
@@ -377,6 +824,76 @@ func (fake *FakeGroupService) GroupsReturnsOnCall(i int, result1 types.GroupList
 	}{result1, result2}
 }
 
+func (fake *FakeGroupService) GroupsByTags(arg1 string, arg2 []string) (types.GroupList, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.groupsByTagsMutex.Lock()
+	ret, specificReturn := fake.groupsByTagsReturnsOnCall[len(fake.groupsByTagsArgsForCall)]
+	fake.groupsByTagsArgsForCall = append(fake.groupsByTagsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.GroupsByTagsStub
+	fakeReturns := fake.groupsByTagsReturns
+	fake.recordInvocation("GroupsByTags", []interface{}{arg1, arg2Copy})
+	fake.groupsByTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) GroupsByTagsCallCount() int {
+	fake.groupsByTagsMutex.RLock()
+	defer fake.groupsByTagsMutex.RUnlock()
+	return len(fake.groupsByTagsArgsForCall)
+}
+
+func (fake *FakeGroupService) GroupsByTagsCalls(stub func(string, []string) (types.GroupList, error)) {
+	fake.groupsByTagsMutex.Lock()
+	defer fake.groupsByTagsMutex.Unlock()
+	fake.GroupsByTagsStub = stub
+}
+
+func (fake *FakeGroupService) GroupsByTagsArgsForCall(i int) (string, []string) {
+	fake.groupsByTagsMutex.RLock()
+	defer fake.groupsByTagsMutex.RUnlock()
+	argsForCall := fake.groupsByTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeGroupService) GroupsByTagsReturns(result1 types.GroupList, result2 error) {
+	fake.groupsByTagsMutex.Lock()
+	defer fake.groupsByTagsMutex.Unlock()
+	fake.GroupsByTagsStub = nil
+	fake.groupsByTagsReturns = struct {
+		result1 types.GroupList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) GroupsByTagsReturnsOnCall(i int, result1 types.GroupList, result2 error) {
+	fake.groupsByTagsMutex.Lock()
+	defer fake.groupsByTagsMutex.Unlock()
+	fake.GroupsByTagsStub = nil
+	if fake.groupsByTagsReturnsOnCall == nil {
+		fake.groupsByTagsReturnsOnCall = make(map[int]struct {
+			result1 types.GroupList
+			result2 error
+		})
+	}
+	fake.groupsByTagsReturnsOnCall[i] = struct {
+		result1 types.GroupList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeGroupService) RemoveGroup(arg1 string, arg2 string) (*groups.RemoveGroupResponseDataDetails, error) {
 	fake.removeGroupMutex.Lock()
 	ret, specificReturn := fake.removeGroupReturnsOnCall[len(fake.removeGroupArgsForCall)]
@@ -578,23 +1095,113 @@ func (fake *FakeGroupService) UnGroupClustersReturnsOnCall(i int, result1 *group
 	}{result1, result2}
 }
 
+func (fake *FakeGroupService) UnassignClusterGroups(arg1 string, arg2 []string, arg3 []string) (*groups.UnassignClusterGroupsResponseDataDetails, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.unassignClusterGroupsMutex.Lock()
+	ret, specificReturn := fake.unassignClusterGroupsReturnsOnCall[len(fake.unassignClusterGroupsArgsForCall)]
+	fake.unassignClusterGroupsArgsForCall = append(fake.unassignClusterGroupsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 []string
+	}{arg1, arg2Copy, arg3Copy})
+	stub := fake.UnassignClusterGroupsStub
+	fakeReturns := fake.unassignClusterGroupsReturns
+	fake.recordInvocation("UnassignClusterGroups", []interface{}{arg1, arg2Copy, arg3Copy})
+	fake.unassignClusterGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeGroupService) UnassignClusterGroupsCallCount() int {
+	fake.unassignClusterGroupsMutex.RLock()
+	defer fake.unassignClusterGroupsMutex.RUnlock()
+	return len(fake.unassignClusterGroupsArgsForCall)
+}
+
+func (fake *FakeGroupService) UnassignClusterGroupsCalls(stub func(string, []string, []string) (*groups.UnassignClusterGroupsResponseDataDetails, error)) {
+	fake.unassignClusterGroupsMutex.Lock()
+	defer fake.unassignClusterGroupsMutex.Unlock()
+	fake.UnassignClusterGroupsStub = stub
+}
+
+func (fake *FakeGroupService) UnassignClusterGroupsArgsForCall(i int) (string, []string, []string) {
+	fake.unassignClusterGroupsMutex.RLock()
+	defer fake.unassignClusterGroupsMutex.RUnlock()
+	argsForCall := fake.unassignClusterGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGroupService) UnassignClusterGroupsReturns(result1 *groups.UnassignClusterGroupsResponseDataDetails, result2 error) {
+	fake.unassignClusterGroupsMutex.Lock()
+	defer fake.unassignClusterGroupsMutex.Unlock()
+	fake.UnassignClusterGroupsStub = nil
+	fake.unassignClusterGroupsReturns = struct {
+		result1 *groups.UnassignClusterGroupsResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeGroupService) UnassignClusterGroupsReturnsOnCall(i int, result1 *groups.UnassignClusterGroupsResponseDataDetails, result2 error) {
+	fake.unassignClusterGroupsMutex.Lock()
+	defer fake.unassignClusterGroupsMutex.Unlock()
+	fake.UnassignClusterGroupsStub = nil
+	if fake.unassignClusterGroupsReturnsOnCall == nil {
+		fake.unassignClusterGroupsReturnsOnCall = make(map[int]struct {
+			result1 *groups.UnassignClusterGroupsResponseDataDetails
+			result2 error
+		})
+	}
+	fake.unassignClusterGroupsReturnsOnCall[i] = struct {
+		result1 *groups.UnassignClusterGroupsResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeGroupService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addGroupMutex.RLock()
 	defer fake.addGroupMutex.RUnlock()
+	fake.addGroupWithOptionsMutex.RLock()
+	defer fake.addGroupWithOptionsMutex.RUnlock()
+	fake.assignClusterGroupsMutex.RLock()
+	defer fake.assignClusterGroupsMutex.RUnlock()
+	fake.editClusterGroupsMutex.RLock()
+	defer fake.editClusterGroupsMutex.RUnlock()
+	fake.editGroupMutex.RLock()
+	defer fake.editGroupMutex.RUnlock()
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
 	fake.groupByNameMutex.RLock()
 	defer fake.groupByNameMutex.RUnlock()
 	fake.groupClustersMutex.RLock()
 	defer fake.groupClustersMutex.RUnlock()
 	fake.groupsMutex.RLock()
 	defer fake.groupsMutex.RUnlock()
+	fake.groupsByTagsMutex.RLock()
+	defer fake.groupsByTagsMutex.RUnlock()
 	fake.removeGroupMutex.RLock()
 	defer fake.removeGroupMutex.RUnlock()
 	fake.removeGroupByNameMutex.RLock()
 	defer fake.removeGroupByNameMutex.RUnlock()
 	fake.unGroupClustersMutex.RLock()
 	defer fake.unGroupClustersMutex.RUnlock()
+	fake.unassignClusterGroupsMutex.RLock()
+	defer fake.unassignClusterGroupsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -1,6 +1,9 @@
 package groups
 
-import "github.com/IBM/satcon-client-go/client/actions"
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
 
 const (
 	QueryRemoveGroup       = "removeGroup"
@@ -36,32 +39,12 @@ func NewRemoveGroupVariables(orgID, uuid string) RemoveGroupVariables {
 	return vars
 }
 
-type RemoveGroupResponse struct {
-	Data *RemoveGroupResponseData `json:"data,omitempty"`
-}
-
-type RemoveGroupResponseData struct {
-	Details *RemoveGroupResponseDataDetails `json:"removeGroup,omitempty"`
-}
-
 type RemoveGroupResponseDataDetails struct {
 	UUID string `json:"uuid,omitempty"`
 }
 
 func (c *Client) RemoveGroup(orgID, uuid string) (*RemoveGroupResponseDataDetails, error) {
-	var response RemoveGroupResponse
-
 	vars := NewRemoveGroupVariables(orgID, uuid)
 
-	err := c.DoQuery(RemoveGroupVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*RemoveGroupResponseDataDetails](&c.SatConClient, QueryRemoveGroup, RemoveGroupVarTemplate, vars, nil)
 }
@@ -1,6 +1,9 @@
 package groups
 
-import "github.com/IBM/satcon-client-go/client/actions"
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
 
 const (
 	QueryRemoveGroupByName       = "removeGroupByName"
@@ -36,32 +39,12 @@ func NewRemoveGroupByNameVariables(orgID, name string) RemoveGroupByNameVariable
 	return vars
 }
 
-type RemoveGroupByNameResponse struct {
-	Data *RemoveGroupByNameResponseData `json:"data,omitempty"`
-}
-
-type RemoveGroupByNameResponseData struct {
-	Details *RemoveGroupByNameResponseDataDetails `json:"removeGroupByName,omitempty"`
-}
-
 type RemoveGroupByNameResponseDataDetails struct {
 	UUID string `json:"uuid,omitempty"`
 }
 
 func (c *Client) RemoveGroupByName(orgID, name string) (*RemoveGroupByNameResponseDataDetails, error) {
-	var response RemoveGroupByNameResponse
-
 	vars := NewRemoveGroupByNameVariables(orgID, name)
 
-	err := c.DoQuery(RemoveGroupByNameVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*RemoveGroupByNameResponseDataDetails](&c.SatConClient, QueryRemoveGroupByName, RemoveGroupByNameVarTemplate, vars, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -61,19 +62,15 @@ var _ = Describe("RemoveGroup", func() {
 
 	Describe("RemoveGroup", func() {
 		var (
-			rgResponse RemoveGroupResponse
+			rgResponse *RemoveGroupResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			rgResponse = RemoveGroupResponse{
-				Data: &RemoveGroupResponseData{
-					Details: &RemoveGroupResponseDataDetails{
-						UUID: "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
-					},
-				},
+			rgResponse = &RemoveGroupResponseDataDetails{
+				UUID: "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
 			}
 
-			respBodyBytes, err := json.Marshal(rgResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryRemoveGroup: rgResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -89,7 +86,7 @@ var _ = Describe("RemoveGroup", func() {
 			details, _ := c.RemoveGroup(orgID, uuid)
 			Expect(details).NotTo(BeNil())
 
-			expected := rgResponse.Data.Details
+			expected := rgResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -109,13 +106,13 @@ var _ = Describe("RemoveGroup", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(RemoveGroupResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.RemoveGroup(orgID, uuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
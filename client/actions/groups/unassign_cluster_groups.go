@@ -0,0 +1,54 @@
+package groups
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryUnassignClusterGroups       = "unassignClusterGroups"
+	UnassignClusterGroupsVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"groupUuids":[{{range $i,$e := .GroupUUIDs}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}],"clusterIds":[{{range $i,$e := .ClusterIDs}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}]{{end}}`
+)
+
+// UnassignClusterGroupsVariables are the variables specific to removing clusters from groups.
+// These include the organization ID, group UUIDs, and cluster IDs.  Rather than
+// instantiating this directly, use NewUnassignClusterGroupsVariables().
+type UnassignClusterGroupsVariables struct {
+	actions.GraphQLQuery
+	OrgID      string
+	GroupUUIDs []string
+	ClusterIDs []string
+}
+
+// NewUnassignClusterGroupsVariables creates a correctly formed instance of UnassignClusterGroupsVariables.
+func NewUnassignClusterGroupsVariables(orgID string, groupUUIDs, clusterIDs []string) UnassignClusterGroupsVariables {
+	vars := UnassignClusterGroupsVariables{
+		OrgID:      orgID,
+		GroupUUIDs: groupUUIDs,
+		ClusterIDs: clusterIDs,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryUnassignClusterGroups
+	vars.Args = map[string]string{
+		"orgId":      "String!",
+		"groupUuids": "[String!]!",
+		"clusterIds": "[String!]!",
+	}
+	vars.Returns = []string{
+		"modified",
+	}
+
+	return vars
+}
+
+type UnassignClusterGroupsResponseDataDetails struct {
+	Modified int `json:"modified,omitempty"`
+}
+
+// UnassignClusterGroups removes every cluster from every group.
+func (c *Client) UnassignClusterGroups(orgID string, groupUUIDs, clusterIDs []string) (*UnassignClusterGroupsResponseDataDetails, error) {
+	vars := NewUnassignClusterGroupsVariables(orgID, groupUUIDs, clusterIDs)
+
+	return web.Do[*UnassignClusterGroupsResponseDataDetails](&c.SatConClient, QueryUnassignClusterGroups, UnassignClusterGroupsVarTemplate, vars, nil)
+}
@@ -0,0 +1,123 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("UnassignClusterGroups", func() {
+	var (
+		orgID          string
+		groupUUIDs     []string
+		clusterIDs     []string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		groupUUIDs = []string{"group1", "group2"}
+		clusterIDs = []string{"cluster1", "cluster2", "cluster3"}
+	})
+
+	Describe("NewUnassignClusterGroupsVariables", func() {
+		It("Returns a correctly populated instance of UnassignClusterGroupsVariables", func() {
+			vars := NewUnassignClusterGroupsVariables(orgID, groupUUIDs, clusterIDs)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryUnassignClusterGroups))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.GroupUUIDs).To(Equal(groupUUIDs))
+			Expect(vars.ClusterIDs).To(Equal(clusterIDs))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":      "String!",
+				"groupUuids": "[String!]!",
+				"clusterIds": "[String!]!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"modified",
+			))
+		})
+	})
+
+	Describe("UnassignClusterGroups", func() {
+		var (
+			c        GroupService
+			h        *webfakes.FakeHTTPClient
+			response *http.Response
+			details  *UnassignClusterGroupsResponseDataDetails
+		)
+
+		BeforeEach(func() {
+			details = &UnassignClusterGroupsResponseDataDetails{
+				Modified: 6,
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryUnassignClusterGroups: details}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Sends every group UUID and cluster ID", func() {
+			_, err := c.UnassignClusterGroups(orgID, groupUUIDs, clusterIDs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":      orgID,
+				"groupUuids": []interface{}{"group1", "group2"},
+				"clusterIds": []interface{}{"cluster1", "cluster2", "cluster3"},
+			}))
+		})
+
+		It("Returns the response details", func() {
+			result, _ := c.UnassignClusterGroups(orgID, groupUUIDs, clusterIDs)
+			Expect(result).To(Equal(details))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.UnassignClusterGroups(orgID, groupUUIDs, clusterIDs)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				result, err := c.UnassignClusterGroups(orgID, groupUUIDs, clusterIDs)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -77,18 +78,14 @@ var _ = Describe("UngroupCluster", func() {
 			c                       groups.GroupService
 			h                       *webfakes.FakeHTTPClient
 			response                *http.Response
-			unGroupClustersResponse groups.UnGroupClustersResponse
+			unGroupClustersResponse *groups.UnGroupClustersResponseDataDetails
 		)
 		BeforeEach(func() {
 
-			unGroupClustersResponse = groups.UnGroupClustersResponse{
-				Data: &groups.UnGroupClustersResponseData{
-					Details: &groups.UnGroupClustersResponseDataDetails{
-						Modified: 5,
-					},
-				},
+			unGroupClustersResponse = &groups.UnGroupClustersResponseDataDetails{
+				Modified: 5,
 			}
-			respBodyBytes, err := json.Marshal(unGroupClustersResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{groups.QueryUnGroupClusters: unGroupClustersResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response = &http.Response{
@@ -107,7 +104,7 @@ var _ = Describe("UngroupCluster", func() {
 		It("Returns the response details", func() {
 			details, _ := c.UnGroupClusters(orgID, uuid, clusters)
 			Expect(details).NotTo(BeNil())
-			expected := unGroupClustersResponse.Data.Details
+			expected := unGroupClustersResponse
 			Expect(*details).To(Equal(*expected))
 		})
 		Context("When query execution errors", func() {
@@ -122,13 +119,13 @@ var _ = Describe("UngroupCluster", func() {
 		})
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(groups.UnGroupClustersResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.UnGroupClusters(orgID, uuid, clusters)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -1,6 +1,9 @@
 package groups
 
-import "github.com/IBM/satcon-client-go/client/actions"
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
 
 const (
 	QueryUnGroupClusters       = "unGroupClusters"
@@ -39,34 +42,12 @@ func NewUnGroupClustersVariables(orgID, uuid string, clusters []string) UnGroupC
 	return vars
 }
 
-// UnGroupClustersResponse is the response body we get upon a successful cluster
-// registration.
-type UnGroupClustersResponse struct {
-	Data *UnGroupClustersResponseData `json:"data,omitempty"`
-}
-
-type UnGroupClustersResponseData struct {
-	Details *UnGroupClustersResponseDataDetails `json:"unGroupClusters,omitempty"`
-}
-
 type UnGroupClustersResponseDataDetails struct {
 	Modified int `json:"modified,omitempty"`
 }
 
 func (c *Client) UnGroupClusters(orgID, uuid string, clusters []string) (*UnGroupClustersResponseDataDetails, error) {
-	var response UnGroupClustersResponse
-
 	vars := NewUnGroupClustersVariables(orgID, uuid, clusters)
 
-	err := c.DoQuery(UnGroupClustersVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*UnGroupClustersResponseDataDetails](&c.SatConClient, QueryUnGroupClusters, UnGroupClustersVarTemplate, vars, nil)
 }
@@ -0,0 +1,58 @@
+package orgkeys
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryAddOrgKey       = "addOrgKey"
+	AddOrgKeyVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"name":{{json .Name}},"primary":{{json .Primary}}{{end}}`
+)
+
+// AddOrgKeyVariables are the variables specific to adding an org key.
+// These include the organization ID, the key name and whether it becomes the
+// primary key.  Rather than instantiating this directly, use NewAddOrgKeyVariables().
+type AddOrgKeyVariables struct {
+	actions.GraphQLQuery
+	OrgID   string
+	Name    string
+	Primary bool
+}
+
+// NewAddOrgKeyVariables creates a correctly formed instance of AddOrgKeyVariables.
+func NewAddOrgKeyVariables(orgID, name string, primary bool) AddOrgKeyVariables {
+	vars := AddOrgKeyVariables{
+		OrgID:   orgID,
+		Name:    name,
+		Primary: primary,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryAddOrgKey
+	vars.Args = map[string]string{
+		"orgId":   "String!",
+		"name":    "String!",
+		"primary": "Boolean!",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"key",
+	}
+
+	return vars
+}
+
+// AddOrgKeyResponseDataDetails holds the new org key.  OrgKeys does not return
+// the key, so the caller must store it.
+type AddOrgKeyResponseDataDetails struct {
+	UUID string `json:"uuid,omitempty"`
+	Key  string `json:"key,omitempty"`
+}
+
+// AddOrgKey creates an org key.  A new primary key replaces the current primary key.
+func (c *Client) AddOrgKey(orgID, name string, primary bool) (*AddOrgKeyResponseDataDetails, error) {
+	vars := NewAddOrgKeyVariables(orgID, name, primary)
+
+	return web.Do[*AddOrgKeyResponseDataDetails](&c.SatConClient, QueryAddOrgKey, AddOrgKeyVarTemplate, vars, nil)
+}
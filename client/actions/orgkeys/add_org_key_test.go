@@ -0,0 +1,112 @@
+package orgkeys_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Adding an OrgKey", func() {
+	var (
+		orgID, name    string
+		c              OrgKeyService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		name = "somekey"
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewAddOrgKeyVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewAddOrgKeyVariables(orgID, name, true)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryAddOrgKey))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Name).To(Equal(name))
+			Expect(vars.Primary).To(BeTrue())
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":   "String!",
+				"name":    "String!",
+				"primary": "Boolean!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"key",
+			))
+		})
+	})
+
+	Describe("AddOrgKey", func() {
+		var addOrgKeyResponse *AddOrgKeyResponseDataDetails
+
+		BeforeEach(func() {
+			addOrgKeyResponse = &AddOrgKeyResponseDataDetails{
+				UUID: "key-uuid",
+				Key:  "orgApiKey-secret",
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddOrgKey: addOrgKeyResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the http request", func() {
+			_, err := c.AddOrgKey(orgID, name, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the new key", func() {
+			details, _ := c.AddOrgKey(orgID, name, true)
+			Expect(details).To(Equal(addOrgKeyResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.AddOrgKey(orgID, name, true)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.AddOrgKey(orgID, name, true)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
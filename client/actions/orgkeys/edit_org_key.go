@@ -0,0 +1,59 @@
+package orgkeys
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEditOrgKey       = "editOrgKey"
+	EditOrgKeyVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}},"name":{{json .Name}},"primary":{{json .Primary}}{{end}}`
+)
+
+// EditOrgKeyVariables are the variables specific to editing an org key.
+// An empty Name or nil Primary leaves that attribute unchanged.  Rather than
+// instantiating this directly, use NewEditOrgKeyVariables().
+type EditOrgKeyVariables struct {
+	actions.GraphQLQuery
+	OrgID   string
+	UUID    string
+	Name    *string
+	Primary *bool
+}
+
+// NewEditOrgKeyVariables creates a correctly formed instance of EditOrgKeyVariables.
+func NewEditOrgKeyVariables(orgID, uuid, name string, primary *bool) EditOrgKeyVariables {
+	vars := EditOrgKeyVariables{
+		OrgID:   orgID,
+		UUID:    uuid,
+		Primary: primary,
+	}
+	if name != "" {
+		vars.Name = &name
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEditOrgKey
+	vars.Args = map[string]string{
+		"orgId":   "String!",
+		"uuid":    "String!",
+		"name":    "String",
+		"primary": "Boolean",
+	}
+	vars.Returns = []string{
+		"modified",
+	}
+
+	return vars
+}
+
+type EditOrgKeyResponseDataDetails struct {
+	Modified int `json:"modified"`
+}
+
+// EditOrgKey renames an org key and/or changes whether it is the primary key
+func (c *Client) EditOrgKey(orgID, uuid, name string, primary *bool) (*EditOrgKeyResponseDataDetails, error) {
+	vars := NewEditOrgKeyVariables(orgID, uuid, name, primary)
+
+	return web.Do[*EditOrgKeyResponseDataDetails](&c.SatConClient, QueryEditOrgKey, EditOrgKeyVarTemplate, vars, nil)
+}
@@ -0,0 +1,122 @@
+package orgkeys_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Editing an OrgKey", func() {
+	var (
+		orgID, uuid, name string
+		primary           bool
+		c                 OrgKeyService
+		h                 *webfakes.FakeHTTPClient
+		response          *http.Response
+		fakeAuthClient    authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		uuid = "key-uuid"
+		name = "newname"
+		primary = true
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewEditOrgKeyVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewEditOrgKeyVariables(orgID, uuid, name, &primary)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryEditOrgKey))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(uuid))
+			Expect(*vars.Name).To(Equal(name))
+			Expect(*vars.Primary).To(BeTrue())
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":   "String!",
+				"uuid":    "String!",
+				"name":    "String",
+				"primary": "Boolean",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"modified",
+			))
+		})
+
+		It("Leaves unset attributes out", func() {
+			vars := NewEditOrgKeyVariables(orgID, uuid, "", nil)
+			Expect(vars.Name).To(BeNil())
+			Expect(vars.Primary).To(BeNil())
+		})
+	})
+
+	Describe("EditOrgKey", func() {
+		BeforeEach(func() {
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryEditOrgKey: EditOrgKeyResponseDataDetails{Modified: 1}}})
+			Expect(err).NotTo(HaveOccurred())
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends null for unchanged attributes", func() {
+			_, err := c.EditOrgKey(orgID, uuid, "", &primary)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("name", BeNil()))
+			Expect(body.Variables).To(HaveKeyWithValue("primary", BeTrue()))
+		})
+
+		It("Returns the number of keys modified", func() {
+			details, _ := c.EditOrgKey(orgID, uuid, name, nil)
+			Expect(details.Modified).To(Equal(1))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.EditOrgKey(orgID, uuid, name, nil)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.EditOrgKey(orgID, uuid, name, nil)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
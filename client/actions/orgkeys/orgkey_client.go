@@ -0,0 +1,47 @@
+package orgkeys
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+// OrgKeyService is the interface used to manage the org keys Razee agents use
+// to authenticate with Satellite Config.
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . OrgKeyService
+type OrgKeyService interface {
+	OrgKeys(orgID string) (types.OrgKeyList, error)
+	AddOrgKey(orgID, name string, primary bool) (*AddOrgKeyResponseDataDetails, error)
+	EditOrgKey(orgID, uuid, name string, primary *bool) (*EditOrgKeyResponseDataDetails, error)
+	RemoveOrgKey(orgID, uuid string, forceDeletion bool) (*RemoveOrgKeyResponseDataDetails, error)
+}
+
+// Client is an implementation of a satcon client.
+type Client struct {
+	web.SatConClient
+}
+
+// NewClient returns a configured instance of OrgKeyService which can then be used
+// to perform org key queries against Satellite Config.
+func NewClient(endpointURL string, httpClient web.HTTPClient, authClient auth.AuthClient) (OrgKeyService, error) {
+	if endpointURL == "" {
+		return nil, errors.New("Must supply a valid endpoint URL")
+	}
+
+	s := web.SatConClient{
+		Endpoint:   endpointURL,
+		HTTPClient: http.DefaultClient,
+		AuthClient: authClient,
+	}
+
+	if httpClient != nil {
+		s.HTTPClient = httpClient
+	}
+
+	return &Client{
+		s,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package orgkeys
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryOrgKeys       = "orgKeys"
+	OrgKeysVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}}{{end}}`
+)
+
+// OrgKeysVariables are the variables specific to querying org keys.
+// Rather than instantiating this directly, use NewOrgKeysVariables().
+type OrgKeysVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+}
+
+// NewOrgKeysVariables creates a correctly formed instance of OrgKeysVariables.
+func NewOrgKeysVariables(orgID string) OrgKeysVariables {
+	vars := OrgKeysVariables{
+		OrgID: orgID,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryOrgKeys
+	vars.Args = map[string]string{
+		"orgId": "String!",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"name",
+		"primary",
+		"created",
+		"updated",
+	}
+
+	return vars
+}
+
+// OrgKeys returns the org keys of the organization, without the keys themselves
+func (c *Client) OrgKeys(orgID string) (types.OrgKeyList, error) {
+	vars := NewOrgKeysVariables(orgID)
+
+	return web.Do[types.OrgKeyList](&c.SatConClient, QueryOrgKeys, OrgKeysVarTemplate, vars, nil)
+}
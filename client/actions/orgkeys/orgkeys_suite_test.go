@@ -0,0 +1,13 @@
+package orgkeys_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrgKeys(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OrgKeys Suite")
+}
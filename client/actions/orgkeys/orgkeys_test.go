@@ -0,0 +1,112 @@
+package orgkeys_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("OrgKeys", func() {
+	var (
+		orgID          string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+	})
+
+	Describe("NewOrgKeysVariables", func() {
+		It("Returns a correctly populated instance of OrgKeysVariables", func() {
+			vars := NewOrgKeysVariables(orgID)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QueryOrgKeys))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId": "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"name",
+				"primary",
+				"created",
+				"updated",
+			))
+		})
+	})
+
+	Describe("OrgKeys", func() {
+		var (
+			orgKeysResponse types.OrgKeyList
+			c               OrgKeyService
+			h               *webfakes.FakeHTTPClient
+			response        *http.Response
+		)
+
+		BeforeEach(func() {
+			orgKeysResponse = types.OrgKeyList{
+				{UUID: "key1", Name: "first", Primary: true, Created: types.Timestamp{Time: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+				{UUID: "key2", Name: "second"},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryOrgKeys: orgKeysResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.OrgKeys(orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the org keys", func() {
+			orgKeys, _ := c.OrgKeys(orgID)
+			Expect(orgKeys).To(Equal(orgKeysResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.OrgKeys(orgID)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				orgKeys, err := c.OrgKeys(orgID)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(orgKeys).To(BeNil())
+			})
+		})
+	})
+})
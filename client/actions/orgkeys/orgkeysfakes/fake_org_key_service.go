@@ -0,0 +1,368 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package orgkeysfakes
+
+import (
+	"sync"
+
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+type FakeOrgKeyService struct {
+	AddOrgKeyStub        func(string, string, bool) (*orgkeys.AddOrgKeyResponseDataDetails, error)
+	addOrgKeyMutex       sync.RWMutex
+	addOrgKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	addOrgKeyReturns struct {
+		result1 *orgkeys.AddOrgKeyResponseDataDetails
+		result2 error
+	}
+	addOrgKeyReturnsOnCall map[int]struct {
+		result1 *orgkeys.AddOrgKeyResponseDataDetails
+		result2 error
+	}
+	EditOrgKeyStub        func(string, string, string, *bool) (*orgkeys.EditOrgKeyResponseDataDetails, error)
+	editOrgKeyMutex       sync.RWMutex
+	editOrgKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *bool
+	}
+	editOrgKeyReturns struct {
+		result1 *orgkeys.EditOrgKeyResponseDataDetails
+		result2 error
+	}
+	editOrgKeyReturnsOnCall map[int]struct {
+		result1 *orgkeys.EditOrgKeyResponseDataDetails
+		result2 error
+	}
+	OrgKeysStub        func(string) (types.OrgKeyList, error)
+	orgKeysMutex       sync.RWMutex
+	orgKeysArgsForCall []struct {
+		arg1 string
+	}
+	orgKeysReturns struct {
+		result1 types.OrgKeyList
+		result2 error
+	}
+	orgKeysReturnsOnCall map[int]struct {
+		result1 types.OrgKeyList
+		result2 error
+	}
+	RemoveOrgKeyStub        func(string, string, bool) (*orgkeys.RemoveOrgKeyResponseDataDetails, error)
+	removeOrgKeyMutex       sync.RWMutex
+	removeOrgKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	removeOrgKeyReturns struct {
+		result1 *orgkeys.RemoveOrgKeyResponseDataDetails
+		result2 error
+	}
+	removeOrgKeyReturnsOnCall map[int]struct {
+		result1 *orgkeys.RemoveOrgKeyResponseDataDetails
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOrgKeyService) AddOrgKey(arg1 string, arg2 string, arg3 bool) (*orgkeys.AddOrgKeyResponseDataDetails, error) {
+	fake.addOrgKeyMutex.Lock()
+	ret, specificReturn := fake.addOrgKeyReturnsOnCall[len(fake.addOrgKeyArgsForCall)]
+	fake.addOrgKeyArgsForCall = append(fake.addOrgKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.AddOrgKeyStub
+	fakeReturns := fake.addOrgKeyReturns
+	fake.recordInvocation("AddOrgKey", []interface{}{arg1, arg2, arg3})
+	fake.addOrgKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrgKeyService) AddOrgKeyCallCount() int {
+	fake.addOrgKeyMutex.RLock()
+	defer fake.addOrgKeyMutex.RUnlock()
+	return len(fake.addOrgKeyArgsForCall)
+}
+
+func (fake *FakeOrgKeyService) AddOrgKeyCalls(stub func(string, string, bool) (*orgkeys.AddOrgKeyResponseDataDetails, error)) {
+	fake.addOrgKeyMutex.Lock()
+	defer fake.addOrgKeyMutex.Unlock()
+	fake.AddOrgKeyStub = stub
+}
+
+func (fake *FakeOrgKeyService) AddOrgKeyArgsForCall(i int) (string, string, bool) {
+	fake.addOrgKeyMutex.RLock()
+	defer fake.addOrgKeyMutex.RUnlock()
+	argsForCall := fake.addOrgKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOrgKeyService) AddOrgKeyReturns(result1 *orgkeys.AddOrgKeyResponseDataDetails, result2 error) {
+	fake.addOrgKeyMutex.Lock()
+	defer fake.addOrgKeyMutex.Unlock()
+	fake.AddOrgKeyStub = nil
+	fake.addOrgKeyReturns = struct {
+		result1 *orgkeys.AddOrgKeyResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) AddOrgKeyReturnsOnCall(i int, result1 *orgkeys.AddOrgKeyResponseDataDetails, result2 error) {
+	fake.addOrgKeyMutex.Lock()
+	defer fake.addOrgKeyMutex.Unlock()
+	fake.AddOrgKeyStub = nil
+	if fake.addOrgKeyReturnsOnCall == nil {
+		fake.addOrgKeyReturnsOnCall = make(map[int]struct {
+			result1 *orgkeys.AddOrgKeyResponseDataDetails
+			result2 error
+		})
+	}
+	fake.addOrgKeyReturnsOnCall[i] = struct {
+		result1 *orgkeys.AddOrgKeyResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) EditOrgKey(arg1 string, arg2 string, arg3 string, arg4 *bool) (*orgkeys.EditOrgKeyResponseDataDetails, error) {
+	fake.editOrgKeyMutex.Lock()
+	ret, specificReturn := fake.editOrgKeyReturnsOnCall[len(fake.editOrgKeyArgsForCall)]
+	fake.editOrgKeyArgsForCall = append(fake.editOrgKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EditOrgKeyStub
+	fakeReturns := fake.editOrgKeyReturns
+	fake.recordInvocation("EditOrgKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.editOrgKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrgKeyService) EditOrgKeyCallCount() int {
+	fake.editOrgKeyMutex.RLock()
+	defer fake.editOrgKeyMutex.RUnlock()
+	return len(fake.editOrgKeyArgsForCall)
+}
+
+func (fake *FakeOrgKeyService) EditOrgKeyCalls(stub func(string, string, string, *bool) (*orgkeys.EditOrgKeyResponseDataDetails, error)) {
+	fake.editOrgKeyMutex.Lock()
+	defer fake.editOrgKeyMutex.Unlock()
+	fake.EditOrgKeyStub = stub
+}
+
+func (fake *FakeOrgKeyService) EditOrgKeyArgsForCall(i int) (string, string, string, *bool) {
+	fake.editOrgKeyMutex.RLock()
+	defer fake.editOrgKeyMutex.RUnlock()
+	argsForCall := fake.editOrgKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeOrgKeyService) EditOrgKeyReturns(result1 *orgkeys.EditOrgKeyResponseDataDetails, result2 error) {
+	fake.editOrgKeyMutex.Lock()
+	defer fake.editOrgKeyMutex.Unlock()
+	fake.EditOrgKeyStub = nil
+	fake.editOrgKeyReturns = struct {
+		result1 *orgkeys.EditOrgKeyResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) EditOrgKeyReturnsOnCall(i int, result1 *orgkeys.EditOrgKeyResponseDataDetails, result2 error) {
+	fake.editOrgKeyMutex.Lock()
+	defer fake.editOrgKeyMutex.Unlock()
+	fake.EditOrgKeyStub = nil
+	if fake.editOrgKeyReturnsOnCall == nil {
+		fake.editOrgKeyReturnsOnCall = make(map[int]struct {
+			result1 *orgkeys.EditOrgKeyResponseDataDetails
+			result2 error
+		})
+	}
+	fake.editOrgKeyReturnsOnCall[i] = struct {
+		result1 *orgkeys.EditOrgKeyResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) OrgKeys(arg1 string) (types.OrgKeyList, error) {
+	fake.orgKeysMutex.Lock()
+	ret, specificReturn := fake.orgKeysReturnsOnCall[len(fake.orgKeysArgsForCall)]
+	fake.orgKeysArgsForCall = append(fake.orgKeysArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.OrgKeysStub
+	fakeReturns := fake.orgKeysReturns
+	fake.recordInvocation("OrgKeys", []interface{}{arg1})
+	fake.orgKeysMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrgKeyService) OrgKeysCallCount() int {
+	fake.orgKeysMutex.RLock()
+	defer fake.orgKeysMutex.RUnlock()
+	return len(fake.orgKeysArgsForCall)
+}
+
+func (fake *FakeOrgKeyService) OrgKeysCalls(stub func(string) (types.OrgKeyList, error)) {
+	fake.orgKeysMutex.Lock()
+	defer fake.orgKeysMutex.Unlock()
+	fake.OrgKeysStub = stub
+}
+
+func (fake *FakeOrgKeyService) OrgKeysArgsForCall(i int) string {
+	fake.orgKeysMutex.RLock()
+	defer fake.orgKeysMutex.RUnlock()
+	argsForCall := fake.orgKeysArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOrgKeyService) OrgKeysReturns(result1 types.OrgKeyList, result2 error) {
+	fake.orgKeysMutex.Lock()
+	defer fake.orgKeysMutex.Unlock()
+	fake.OrgKeysStub = nil
+	fake.orgKeysReturns = struct {
+		result1 types.OrgKeyList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) OrgKeysReturnsOnCall(i int, result1 types.OrgKeyList, result2 error) {
+	fake.orgKeysMutex.Lock()
+	defer fake.orgKeysMutex.Unlock()
+	fake.OrgKeysStub = nil
+	if fake.orgKeysReturnsOnCall == nil {
+		fake.orgKeysReturnsOnCall = make(map[int]struct {
+			result1 types.OrgKeyList
+			result2 error
+		})
+	}
+	fake.orgKeysReturnsOnCall[i] = struct {
+		result1 types.OrgKeyList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) RemoveOrgKey(arg1 string, arg2 string, arg3 bool) (*orgkeys.RemoveOrgKeyResponseDataDetails, error) {
+	fake.removeOrgKeyMutex.Lock()
+	ret, specificReturn := fake.removeOrgKeyReturnsOnCall[len(fake.removeOrgKeyArgsForCall)]
+	fake.removeOrgKeyArgsForCall = append(fake.removeOrgKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveOrgKeyStub
+	fakeReturns := fake.removeOrgKeyReturns
+	fake.recordInvocation("RemoveOrgKey", []interface{}{arg1, arg2, arg3})
+	fake.removeOrgKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrgKeyService) RemoveOrgKeyCallCount() int {
+	fake.removeOrgKeyMutex.RLock()
+	defer fake.removeOrgKeyMutex.RUnlock()
+	return len(fake.removeOrgKeyArgsForCall)
+}
+
+func (fake *FakeOrgKeyService) RemoveOrgKeyCalls(stub func(string, string, bool) (*orgkeys.RemoveOrgKeyResponseDataDetails, error)) {
+	fake.removeOrgKeyMutex.Lock()
+	defer fake.removeOrgKeyMutex.Unlock()
+	fake.RemoveOrgKeyStub = stub
+}
+
+func (fake *FakeOrgKeyService) RemoveOrgKeyArgsForCall(i int) (string, string, bool) {
+	fake.removeOrgKeyMutex.RLock()
+	defer fake.removeOrgKeyMutex.RUnlock()
+	argsForCall := fake.removeOrgKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOrgKeyService) RemoveOrgKeyReturns(result1 *orgkeys.RemoveOrgKeyResponseDataDetails, result2 error) {
+	fake.removeOrgKeyMutex.Lock()
+	defer fake.removeOrgKeyMutex.Unlock()
+	fake.RemoveOrgKeyStub = nil
+	fake.removeOrgKeyReturns = struct {
+		result1 *orgkeys.RemoveOrgKeyResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) RemoveOrgKeyReturnsOnCall(i int, result1 *orgkeys.RemoveOrgKeyResponseDataDetails, result2 error) {
+	fake.removeOrgKeyMutex.Lock()
+	defer fake.removeOrgKeyMutex.Unlock()
+	fake.RemoveOrgKeyStub = nil
+	if fake.removeOrgKeyReturnsOnCall == nil {
+		fake.removeOrgKeyReturnsOnCall = make(map[int]struct {
+			result1 *orgkeys.RemoveOrgKeyResponseDataDetails
+			result2 error
+		})
+	}
+	fake.removeOrgKeyReturnsOnCall[i] = struct {
+		result1 *orgkeys.RemoveOrgKeyResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgKeyService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addOrgKeyMutex.RLock()
+	defer fake.addOrgKeyMutex.RUnlock()
+	fake.editOrgKeyMutex.RLock()
+	defer fake.editOrgKeyMutex.RUnlock()
+	fake.orgKeysMutex.RLock()
+	defer fake.orgKeysMutex.RUnlock()
+	fake.removeOrgKeyMutex.RLock()
+	defer fake.removeOrgKeyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOrgKeyService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ orgkeys.OrgKeyService = new(FakeOrgKeyService)
@@ -0,0 +1,54 @@
+package orgkeys
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryRemoveOrgKey       = "removeOrgKey"
+	RemoveOrgKeyVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}},"forceDeletion":{{json .ForceDeletion}}{{end}}`
+)
+
+// RemoveOrgKeyVariables are the variables specific to removing an org key.
+// Razee refuses to remove the primary key, or the only key, unless ForceDeletion
+// is set.  Rather than instantiating this directly, use NewRemoveOrgKeyVariables().
+type RemoveOrgKeyVariables struct {
+	actions.GraphQLQuery
+	OrgID         string
+	UUID          string
+	ForceDeletion bool
+}
+
+// NewRemoveOrgKeyVariables creates a correctly formed instance of RemoveOrgKeyVariables.
+func NewRemoveOrgKeyVariables(orgID, uuid string, forceDeletion bool) RemoveOrgKeyVariables {
+	vars := RemoveOrgKeyVariables{
+		OrgID:         orgID,
+		UUID:          uuid,
+		ForceDeletion: forceDeletion,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryRemoveOrgKey
+	vars.Args = map[string]string{
+		"orgId":         "String!",
+		"uuid":          "String!",
+		"forceDeletion": "Boolean",
+	}
+	vars.Returns = []string{
+		"success",
+	}
+
+	return vars
+}
+
+type RemoveOrgKeyResponseDataDetails struct {
+	Success bool `json:"success"`
+}
+
+// RemoveOrgKey deletes an org key.  Agents still using it can no longer authenticate.
+func (c *Client) RemoveOrgKey(orgID, uuid string, forceDeletion bool) (*RemoveOrgKeyResponseDataDetails, error) {
+	vars := NewRemoveOrgKeyVariables(orgID, uuid, forceDeletion)
+
+	return web.Do[*RemoveOrgKeyResponseDataDetails](&c.SatConClient, QueryRemoveOrgKey, RemoveOrgKeyVarTemplate, vars, nil)
+}
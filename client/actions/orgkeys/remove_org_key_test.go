@@ -0,0 +1,104 @@
+package orgkeys_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Removing an OrgKey", func() {
+	var (
+		orgID, uuid    string
+		c              OrgKeyService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		uuid = "key-uuid"
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewRemoveOrgKeyVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewRemoveOrgKeyVariables(orgID, uuid, true)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryRemoveOrgKey))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(uuid))
+			Expect(vars.ForceDeletion).To(BeTrue())
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":         "String!",
+				"uuid":          "String!",
+				"forceDeletion": "Boolean",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"success",
+			))
+		})
+	})
+
+	Describe("RemoveOrgKey", func() {
+		BeforeEach(func() {
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryRemoveOrgKey: RemoveOrgKeyResponseDataDetails{Success: true}}})
+			Expect(err).NotTo(HaveOccurred())
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the http request", func() {
+			_, err := c.RemoveOrgKey(orgID, uuid, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns whether the key was removed", func() {
+			details, _ := c.RemoveOrgKey(orgID, uuid, false)
+			Expect(details.Success).To(BeTrue())
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.RemoveOrgKey(orgID, uuid, false)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.RemoveOrgKey(orgID, uuid, false)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package orgkeys
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RotationOptions controls RotateOrgKey
+type RotationOptions struct {
+	// Wait is how long to wait between adding the new key and removing the old ones,
+	// giving agents time to switch over.  Ignored if Ready is set.
+	Wait time.Duration
+	// Ready, if set, is called with the new key and should return once every agent
+	// has been given it, e.g. after updating the razee-identity secret on each cluster.
+	// The old keys are only removed if it returns nil.
+	Ready func(ctx context.Context, newKey *AddOrgKeyResponseDataDetails) error
+	// ForceDeletion is passed to RemoveOrgKey for the old keys
+	ForceDeletion bool
+}
+
+// RotationResult describes what RotateOrgKey did.  NewKey is set as soon as the
+// new key has been added, even if the rotation failed afterwards.
+type RotationResult struct {
+	NewKey          *AddOrgKeyResponseDataDetails
+	RemovedKeyUUIDs []string
+}
+
+// RotateOrgKey replaces the org keys of the organization with a new primary key
+// called name.  It adds the new key, waits as described by opts, and then removes
+// every key that existed beforehand.  If ctx is cancelled while waiting, the old
+// keys are left in place.
+func RotateOrgKey(ctx context.Context, service OrgKeyService, orgID, name string, opts RotationOptions) (*RotationResult, error) {
+	oldKeys, err := service.OrgKeys(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RotationResult{}
+	result.NewKey, err = service.AddOrgKey(orgID, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Ready != nil {
+		err = opts.Ready(ctx, result.NewKey)
+	} else {
+		err = sleep(ctx, opts.Wait)
+	}
+	if err != nil {
+		return result, fmt.Errorf("Org key %s was added, but the old keys were not removed: %s", result.NewKey.UUID, err)
+	}
+
+	for _, oldKey := range oldKeys {
+		if oldKey.UUID == result.NewKey.UUID {
+			continue
+		}
+		details, err := service.RemoveOrgKey(orgID, oldKey.UUID, opts.ForceDeletion)
+		if err != nil {
+			return result, fmt.Errorf("Unable to remove org key %s: %s", oldKey.UUID, err)
+		}
+		if details == nil || !details.Success {
+			return result, fmt.Errorf("Unable to remove org key %s", oldKey.UUID)
+		}
+		result.RemovedKeyUUIDs = append(result.RemovedKeyUUIDs, oldKey.UUID)
+	}
+
+	return result, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
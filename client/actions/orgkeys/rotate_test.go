@@ -0,0 +1,108 @@
+package orgkeys_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys/orgkeysfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("RotateOrgKey", func() {
+	var (
+		orgID   string
+		service *orgkeysfakes.FakeOrgKeyService
+		newKey  *AddOrgKeyResponseDataDetails
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		newKey = &AddOrgKeyResponseDataDetails{UUID: "new-key", Key: "secret"}
+
+		service = &orgkeysfakes.FakeOrgKeyService{}
+		service.OrgKeysReturns(types.OrgKeyList{
+			{UUID: "old-primary", Primary: true},
+			{UUID: "old-secondary"},
+		}, nil)
+		service.AddOrgKeyReturns(newKey, nil)
+		service.RemoveOrgKeyReturns(&RemoveOrgKeyResponseDataDetails{Success: true}, nil)
+	})
+
+	It("Adds a new primary key, waits, then removes the old keys", func() {
+		var removedBeforeReady int
+		result, err := RotateOrgKey(context.Background(), service, orgID, "rotated", RotationOptions{
+			Ready: func(ctx context.Context, key *AddOrgKeyResponseDataDetails) error {
+				Expect(key).To(Equal(newKey))
+				removedBeforeReady = service.RemoveOrgKeyCallCount()
+				return nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removedBeforeReady).To(Equal(0))
+
+		Expect(service.AddOrgKeyCallCount()).To(Equal(1))
+		addOrgID, name, primary := service.AddOrgKeyArgsForCall(0)
+		Expect(addOrgID).To(Equal(orgID))
+		Expect(name).To(Equal("rotated"))
+		Expect(primary).To(BeTrue())
+
+		Expect(result.NewKey).To(Equal(newKey))
+		Expect(result.RemovedKeyUUIDs).To(Equal([]string{"old-primary", "old-secondary"}))
+		_, uuid, force := service.RemoveOrgKeyArgsForCall(0)
+		Expect(uuid).To(Equal("old-primary"))
+		Expect(force).To(BeFalse())
+	})
+
+	It("Waits for the configured duration", func() {
+		start := time.Now()
+		_, err := RotateOrgKey(context.Background(), service, orgID, "rotated", RotationOptions{Wait: 50 * time.Millisecond})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		Expect(service.RemoveOrgKeyCallCount()).To(Equal(2))
+	})
+
+	It("Keeps the old keys when the context is cancelled while waiting", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := RotateOrgKey(ctx, service, orgID, "rotated", RotationOptions{Wait: time.Hour})
+		Expect(err).To(MatchError(ContainSubstring("old keys were not removed")))
+		Expect(result.NewKey).To(Equal(newKey))
+		Expect(service.RemoveOrgKeyCallCount()).To(Equal(0))
+	})
+
+	It("Keeps the old keys when Ready fails", func() {
+		_, err := RotateOrgKey(context.Background(), service, orgID, "rotated", RotationOptions{
+			Ready: func(context.Context, *AddOrgKeyResponseDataDetails) error {
+				return errors.New("Cluster unreachable")
+			},
+		})
+		Expect(err).To(MatchError(ContainSubstring("Cluster unreachable")))
+		Expect(service.RemoveOrgKeyCallCount()).To(Equal(0))
+	})
+
+	It("Reports the keys removed before a removal fails", func() {
+		service.RemoveOrgKeyReturnsOnCall(1, nil, errors.New("Kablooie!"))
+		result, err := RotateOrgKey(context.Background(), service, orgID, "rotated", RotationOptions{})
+		Expect(err).To(MatchError(ContainSubstring("old-secondary")))
+		Expect(result.RemovedKeyUUIDs).To(Equal([]string{"old-primary"}))
+	})
+
+	It("Does not report a key as removed when its removal is unsuccessful", func() {
+		service.RemoveOrgKeyReturnsOnCall(1, &RemoveOrgKeyResponseDataDetails{Success: false}, nil)
+		result, err := RotateOrgKey(context.Background(), service, orgID, "rotated", RotationOptions{})
+		Expect(err).To(MatchError("Unable to remove org key old-secondary"))
+		Expect(result.RemovedKeyUUIDs).To(Equal([]string{"old-primary"}))
+	})
+
+	It("Does not add a key when the existing keys cannot be listed", func() {
+		service.OrgKeysReturns(nil, errors.New("Kablooie!"))
+		_, err := RotateOrgKey(context.Background(), service, orgID, "rotated", RotationOptions{})
+		Expect(err).To(MatchError("Kablooie!"))
+		Expect(service.AddOrgKeyCallCount()).To(Equal(0))
+	})
+})
@@ -32,6 +32,11 @@ type GraphQLQuery struct {
 	Returns   []string
 }
 
+// OperationType returns whether the query is a query or a mutation.
+func (q GraphQLQuery) OperationType() QueryType {
+	return q.Type
+}
+
 func BuildArgsList(args map[string]string) string {
 	if len(args) == 0 {
 		return ""
@@ -58,6 +58,13 @@ var _ = Describe("Query", func() {
 			Expect(finalBytes).To(Equal([]byte(`"'apostrophes'" "\"quotes\"" "\\backslashes\\"`)))
 		})
 	})
+	Describe("OperationType", func() {
+		It("Returns the query type", func() {
+			q := GraphQLQuery{Type: QueryTypeMutation}
+			Expect(q.OperationType()).To(Equal(QueryTypeMutation))
+		})
+	})
+
 	Describe("BuildArgsList", func() {
 		It("Returns a string containing a list delimited by ', '", func() {
 			argList := BuildArgsList(argMap)
@@ -3,6 +3,7 @@ package resources
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -43,29 +44,9 @@ func NewResourceContentVariables(orgID, clusterID, resourceSelfLink string) Reso
 	return vars
 }
 
-type ResourceContentResponse struct {
-	Data *ResourceContentResponseData `json:"data,omitempty"`
-}
-
-type ResourceContentResponseData struct {
-	ResourceContent *types.ResourceContentObj `json:"resourceContent,omitempty"`
-}
-
 //ResourceContent retrieves resource content
 func (c *Client) ResourceContent(orgID, clusterID, resourceSelfLink string) (*types.ResourceContentObj, error) {
-	var response ResourceContentResponse
-
 	vars := NewResourceContentVariables(orgID, clusterID, resourceSelfLink)
 
-	err := c.DoQuery(ResourceContentVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.ResourceContent, err
-	}
-
-	return nil, err
+	return web.Do[*types.ResourceContentObj](&c.SatConClient, QueryResourceContent, ResourceContentVarTemplate, vars, nil)
 }
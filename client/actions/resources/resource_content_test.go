@@ -12,6 +12,8 @@ import (
 
 	. "github.com/IBM/satcon-client-go/client/actions/resources"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -24,7 +26,7 @@ var _ = Describe("ResourceContent", func() {
 		response                           *http.Response
 		h                                  *webfakes.FakeHTTPClient
 		fakeAuthClient                     authfakes.FakeAuthClient
-		responseStruct                     ResourceContentResponse
+		responseStruct                     map[string]map[string]*types.ResourceContentObj
 	)
 
 	Describe("NewResourceContentVariables", func() {
@@ -93,7 +95,7 @@ var _ = Describe("ResourceContent", func() {
 		It("Returns resource content for the specified cluster", func() {
 			content, err := r.ResourceContent(orgID, clusterID, resourceSelfLink)
 			Expect(err).NotTo(HaveOccurred())
-			expected := responseStruct.Data.ResourceContent
+			expected := responseStruct["data"][QueryResourceContent]
 			Expect(content).To(Equal(expected))
 		})
 
@@ -110,13 +112,13 @@ var _ = Describe("ResourceContent", func() {
 
 		Context("When response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ResourceContentResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				content, err := r.ResourceContent(orgID, clusterID, resourceSelfLink)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(content).To(BeNil())
 			})
 		})
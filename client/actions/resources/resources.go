@@ -3,6 +3,7 @@ package resources
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -35,30 +36,9 @@ func NewResourcesVariables(orgID string) ResourcesVariables {
 	return vars
 }
 
-// ResourcesResponse query data
-type ResourcesResponse struct {
-	Data *ResourcesResponseData `json:"data,omitempty"`
-}
-
-// ResourcesResponseData encapsulates ResourceList response
-type ResourcesResponseData struct {
-	ResourceList *types.ResourceList `json:"resources,omitempty"`
-}
-
 // Resources queries specified cluster for list of resources, i.e. Pod, Deployment, Service, etc.
 func (c *Client) Resources(orgID string) (*types.ResourceList, error) {
-	var response ResourcesResponse
-
 	vars := NewResourcesVariables(orgID)
 
-	err := c.DoQuery(ResourcesVarTemplate, vars, nil, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.ResourceList, err
-	}
-
-	return nil, err
+	return web.Do[*types.ResourceList](&c.SatConClient, QueryResources, ResourcesVarTemplate, vars, nil)
 }
@@ -3,6 +3,7 @@ package resources
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -44,31 +45,9 @@ func NewResourcesByClusterVariables(orgID, clusterID, filter string, limit int)
 	return vars
 }
 
-// ResourcesByClusterResponse query data
-type ResourcesByClusterResponse struct {
-	Data *ResourcesByClusterResponseData `json:"data,omitempty"`
-}
-
-// ResourcesByClusterResponseData encapsulates ResourceList response
-type ResourcesByClusterResponseData struct {
-	ResourceList *types.ResourceList `json:"resourcesByCluster,omitempty"`
-}
-
 // ResourcesByCluster queries specified cluster for list of resources, i.e. Pod, Deployment, Service, etc.
 func (c *Client) ResourcesByCluster(orgID, clusterID, filter string, limit int) (*types.ResourceList, error) {
-	var response ResourcesByClusterResponse
-
 	vars := NewResourcesByClusterVariables(orgID, clusterID, filter, limit)
 
-	err := c.DoQuery(ResourcesByClusterVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.ResourceList, err
-	}
-
-	return nil, err
+	return web.Do[*types.ResourceList](&c.SatConClient, QueryResourcesByCluster, ResourcesByClusterVarTemplate, vars, nil)
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/resources"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -48,31 +49,27 @@ var _ = Describe("Resources", func() {
 			r                 ResourceService
 			h                 *webfakes.FakeHTTPClient
 			response          *http.Response
-			resourcesResponse ResourcesResponse
+			resourcesResponse *types.ResourceList
 		)
 
 		BeforeEach(func() {
-			resourcesResponse = ResourcesResponse{
-				Data: &ResourcesResponseData{
-					ResourceList: &types.ResourceList{
-						Count: 1,
-						Resources: []types.Resource{
-							{
-								ID:        "indentify-yourself",
-								OrgID:     "what-is-your-organization",
-								ClusterID: "c7bc66fe-82e0-4d24-ad61-ac7773830ebc",
-								Cluster: types.ClusterInfo{
-									ClusterID: "cluster-ID",
-									Name:      "cluster-name",
-								},
-								SelfLink: "/api/v1/namespaces/razeedeploy/pods/watch-keeper-5dd8f8b5b8-k5t5h",
-							},
+			resourcesResponse = &types.ResourceList{
+				Count: 1,
+				Resources: []types.Resource{
+					{
+						ID:        "indentify-yourself",
+						OrgID:     "what-is-your-organization",
+						ClusterID: "c7bc66fe-82e0-4d24-ad61-ac7773830ebc",
+						Cluster: types.ClusterInfo{
+							ClusterID: "cluster-ID",
+							Name:      "cluster-name",
 						},
+						SelfLink: "/api/v1/namespaces/razeedeploy/pods/watch-keeper-5dd8f8b5b8-k5t5h",
 					},
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(resourcesResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryResources: resourcesResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -93,7 +90,7 @@ var _ = Describe("Resources", func() {
 
 		It("Returns resources for the specified orgID", func() {
 			resources, _ := r.Resources(orgID)
-			expected := resourcesResponse.Data.ResourceList
+			expected := resourcesResponse
 			Expect(resources).To(Equal(expected))
 		})
 
@@ -110,13 +107,13 @@ var _ = Describe("Resources", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ResourcesResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				groups, err := r.Resources(orgID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(groups).To(BeNil())
 			})
 		})
@@ -2,6 +2,7 @@ package subscriptions
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -43,16 +44,6 @@ func NewAddSubscriptionVariables(orgID, name, channelUuid, versionUuid string, g
 	return vars
 }
 
-// AddSubscriptionResponse for unmarshalling the response data
-type AddSubscriptionResponse struct {
-	Data *AddSubscriptionResponseData `json:"data,omitempty"`
-}
-
-// AddSubscriptionResponseData for unmarshalling response details
-type AddSubscriptionResponseData struct {
-	Details *AddSubscriptionResponseDataDetails `json:"addSubscription,omitempty"`
-}
-
 // AddSubscriptionResponseDataDetails for unmarshalling response uuid
 type AddSubscriptionResponseDataDetails struct {
 	UUID string `json:"uuid,omitempty"`
@@ -60,19 +51,7 @@ type AddSubscriptionResponseDataDetails struct {
 
 // AddSubscription creates a new subscription for valid channel, version, and group(s)
 func (c *Client) AddSubscription(orgID, name, channelUuid, versionUuid string, groups []string) (*AddSubscriptionResponseDataDetails, error) {
-	var response AddSubscriptionResponse
-
 	vars := NewAddSubscriptionVariables(orgID, name, channelUuid, versionUuid, groups)
 
-	err := c.DoQuery(AddSubscriptionVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, nil
-	}
-
-	return nil, err
+	return web.Do[*AddSubscriptionResponseDataDetails](&c.SatConClient, QueryAddSubscription, AddSubscriptionVarTemplate, vars, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -61,22 +62,18 @@ var _ = Describe("AddSubscription", func() {
 	Describe("AddSubscription", func() {
 
 		var (
-			addSubscriptionResponse AddSubscriptionResponse
+			addSubscriptionResponse *AddSubscriptionResponseDataDetails
 			c                       SubscriptionService
 			httpClient              *webfakes.FakeHTTPClient
 			response                *http.Response
 		)
 
 		BeforeEach(func() {
-			addSubscriptionResponse = AddSubscriptionResponse{
-				Data: &AddSubscriptionResponseData{
-					Details: &AddSubscriptionResponseDataDetails{
-						UUID: "cassini",
-					},
-				},
+			addSubscriptionResponse = &AddSubscriptionResponseDataDetails{
+				UUID: "cassini",
 			}
 
-			respBodyBytes, err := json.Marshal(addSubscriptionResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddSubscription: addSubscriptionResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -98,7 +95,7 @@ var _ = Describe("AddSubscription", func() {
 
 		It("Returns the uuid from the AddChannelReply", func() {
 			uuid, _ := c.AddSubscription(orgID, name, channelUuid, versionUuid, groups)
-			expectedUuid := addSubscriptionResponse.Data.Details
+			expectedUuid := addSubscriptionResponse
 			Expect(uuid).To(Equal(expectedUuid))
 			Expect(uuid.UUID).To(MatchRegexp(expectedUuid.UUID))
 		})
@@ -116,13 +113,13 @@ var _ = Describe("AddSubscription", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(SubscriptionsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				uuid, err := c.AddSubscription(orgID, name, channelUuid, versionUuid, groups)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(uuid).To(BeNil())
 			})
 		})
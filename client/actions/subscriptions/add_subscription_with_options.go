@@ -0,0 +1,66 @@
+package subscriptions
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	AddSubscriptionWithOptionsVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"name":{{json .Name}},"groups":[{{range $i,$e := .Groups}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}],"channelUuid":{{json .ChannelUUID}},"versionUuid":{{json .VersionUUID}},"tags":{{json .Tags}},"custom":{{json .Custom}}{{end}}`
+)
+
+// AddSubscriptionOptions are the optional settings of a new subscription
+type AddSubscriptionOptions struct {
+	types.Attributes
+}
+
+type AddSubscriptionWithOptionsVariables struct {
+	actions.GraphQLQuery
+	OrgID       string
+	Name        string
+	Groups      []string
+	ChannelUUID string
+	VersionUUID string
+	AddSubscriptionOptions
+}
+
+func NewAddSubscriptionWithOptionsVariables(orgID, name, channelUuid, versionUuid string, groups []string, opts AddSubscriptionOptions) AddSubscriptionWithOptionsVariables {
+	vars := AddSubscriptionWithOptionsVariables{
+		OrgID:                  orgID,
+		Name:                   name,
+		Groups:                 groups,
+		ChannelUUID:            channelUuid,
+		VersionUUID:            versionUuid,
+		AddSubscriptionOptions: opts,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryAddSubscription
+	vars.Args = map[string]string{
+		"orgId":       "String!",
+		"name":        "String!",
+		"groups":      "[String!]!",
+		"channelUuid": "String!",
+		"versionUuid": "String!",
+		"tags":        "[String!]",
+		"custom":      "JSON",
+	}
+	vars.Returns = []string{
+		"uuid",
+	}
+
+	return vars
+}
+
+// AddSubscriptionWithOptions creates a new subscription for valid channel, version,
+// and group(s), with the specified tags and custom fields
+func (c *Client) AddSubscriptionWithOptions(orgID, name, channelUuid, versionUuid string, groups []string, opts AddSubscriptionOptions) (*AddSubscriptionResponseDataDetails, error) {
+	if err := opts.Attributes.Validate(); err != nil {
+		return nil, err
+	}
+
+	vars := NewAddSubscriptionWithOptionsVariables(orgID, name, channelUuid, versionUuid, groups, opts)
+
+	return web.Do[*AddSubscriptionResponseDataDetails](&c.SatConClient, QueryAddSubscription, AddSubscriptionWithOptionsVarTemplate, vars, nil)
+}
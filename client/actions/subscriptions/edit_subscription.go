@@ -0,0 +1,105 @@
+package subscriptions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEditSubscription       = "editSubscription"
+	EditSubscriptionVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}},"name":{{json .Name}},"groups":[{{range $i,$e := .Groups}}{{if gt $i 0}},{{end}}{{json $e}}{{end}}],"channelUuid":{{json .ChannelUUID}},"versionUuid":{{json .VersionUUID}}{{with .Attributes}},"tags":{{json .Tags}},"custom":{{json .Custom}}{{end}}{{end}}`
+)
+
+// EditSubscriptionOptions are the changes EditSubscription makes to a subscription.
+// The name, groups, channel and version are always set, so pass the current
+// values to keep them.
+type EditSubscriptionOptions struct {
+	Name        string
+	Groups      []string
+	ChannelUUID string
+	VersionUUID string
+	// Attributes, if set, replace the subscription's tags and custom fields, and
+	// empty attributes clear them.  If nil, the tags and custom fields are not sent.
+	Attributes *types.Attributes
+}
+
+type EditSubscriptionVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	UUID  string
+	EditSubscriptionOptions
+}
+
+func NewEditSubscriptionVariables(orgID, uuid string, opts EditSubscriptionOptions) EditSubscriptionVariables {
+	if opts.Attributes != nil {
+		// Empty tags and custom fields are sent as [] and {} to clear them
+		explicit := opts.Attributes.Explicit()
+		opts.Attributes = &explicit
+	}
+
+	vars := EditSubscriptionVariables{
+		OrgID:                   orgID,
+		UUID:                    uuid,
+		EditSubscriptionOptions: opts,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEditSubscription
+	vars.Args = map[string]string{
+		"orgId":       "String!",
+		"uuid":        "String!",
+		"name":        "String!",
+		"groups":      "[String!]!",
+		"channelUuid": "String!",
+		"versionUuid": "String!",
+		"tags":        "[String!]",
+		"custom":      "JSON",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"success",
+	}
+
+	return vars
+}
+
+// EditSubscriptionResponseDataDetails for unmarshalling the edit response
+type EditSubscriptionResponseDataDetails struct {
+	UUID    string `json:"uuid,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// EditSubscription edits a subscription in place and returns the updated
+// subscription.  Use SetSubscription to change only the version.
+func (c *Client) EditSubscription(orgID, uuid string, opts EditSubscriptionOptions) (*types.Subscription, error) {
+	if opts.Name == "" {
+		return nil, errors.New("Must supply a subscription name")
+	}
+	if opts.ChannelUUID == "" {
+		return nil, errors.New("Must supply a channel UUID")
+	}
+	if opts.VersionUUID == "" {
+		return nil, errors.New("Must supply a version UUID")
+	}
+	if opts.Attributes != nil {
+		if err := opts.Attributes.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	vars := NewEditSubscriptionVariables(orgID, uuid, opts)
+
+	details, err := web.Do[*EditSubscriptionResponseDataDetails](&c.SatConClient, QueryEditSubscription, EditSubscriptionVarTemplate, vars, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !details.Success {
+		return nil, fmt.Errorf("Unable to edit subscription %s", uuid)
+	}
+
+	return c.Subscription(orgID, uuid)
+}
@@ -0,0 +1,274 @@
+package subscriptions_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("EditSubscription", func() {
+	var (
+		orgID, uuid, name        string
+		channelUuid, versionUuid string
+		groups                   []string
+		attrs                    types.Attributes
+		opts                     EditSubscriptionOptions
+		c                        SubscriptionService
+		h                        *webfakes.FakeHTTPClient
+		response                 *http.Response
+		fakeAuthClient           authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		uuid = "subscription-uuid"
+		name = "somesubscription"
+		channelUuid = "channel-uuid"
+		versionUuid = "version-uuid"
+		groups = []string{"group1", "group2"}
+		attrs = types.Attributes{
+			Tags:   []string{"prod"},
+			Custom: map[string]interface{}{"owner": "edge@example.com"},
+		}
+		opts = EditSubscriptionOptions{
+			Name:        name,
+			Groups:      groups,
+			ChannelUUID: channelUuid,
+			VersionUUID: versionUuid,
+			Attributes:  &attrs,
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewEditSubscriptionVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewEditSubscriptionVariables(orgID, uuid, opts)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryEditSubscription))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(uuid))
+			Expect(vars.Name).To(Equal(name))
+			Expect(vars.Groups).To(Equal(groups))
+			Expect(vars.ChannelUUID).To(Equal(channelUuid))
+			Expect(vars.VersionUUID).To(Equal(versionUuid))
+			Expect(vars.Attributes).To(Equal(&attrs))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":       "String!",
+				"uuid":        "String!",
+				"name":        "String!",
+				"groups":      "[String!]!",
+				"channelUuid": "String!",
+				"versionUuid": "String!",
+				"tags":        "[String!]",
+				"custom":      "JSON",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"success",
+			))
+		})
+
+		It("Sends empty attributes explicitly so they clear the existing ones", func() {
+			opts.Attributes = &types.Attributes{}
+			vars := NewEditSubscriptionVariables(orgID, uuid, opts)
+			Expect(vars.Attributes.Tags).To(Equal([]string{}))
+			Expect(vars.Attributes.Custom).To(Equal(map[string]interface{}{}))
+		})
+	})
+
+	Describe("EditSubscription", func() {
+		var (
+			details *EditSubscriptionResponseDataDetails
+			entity  *types.Subscription
+		)
+
+		respond := func(query string, value interface{}) *http.Response {
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{query: value}})
+			Expect(err).NotTo(HaveOccurred())
+			return &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+		}
+
+		BeforeEach(func() {
+			details = &EditSubscriptionResponseDataDetails{
+				UUID:    uuid,
+				Success: true,
+			}
+			entity = &types.Subscription{
+				UUID:        types.SubscriptionUUID(uuid),
+				OrgID:       types.OrgID(orgID),
+				Name:        name,
+				Groups:      groups,
+				ChannelUUID: types.ChannelUUID(channelUuid),
+				VersionUUID: types.VersionUUID(versionUuid),
+				Attributes:  attrs,
+			}
+
+			h.DoReturnsOnCall(0, respond(QueryEditSubscription, details), nil)
+			h.DoReturnsOnCall(1, respond(QuerySubscription, entity), nil)
+		})
+
+		It("Sends the edit and then fetches the subscription", func() {
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(2))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":       orgID,
+				"uuid":        uuid,
+				"name":        name,
+				"groups":      []interface{}{"group1", "group2"},
+				"channelUuid": channelUuid,
+				"versionUuid": versionUuid,
+				"tags":        []interface{}{"prod"},
+				"custom":      map[string]interface{}{"owner": "edge@example.com"},
+			}))
+
+			var fetch struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(1).Body).Decode(&fetch)).To(Succeed())
+			Expect(fetch.Variables).To(Equal(map[string]interface{}{
+				"orgId": orgID,
+				"uuid":  uuid,
+			}))
+		})
+
+		It("Leaves out the tags and custom fields when no attributes are given", func() {
+			opts.Attributes = nil
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).NotTo(HaveKey("tags"))
+			Expect(body.Variables).NotTo(HaveKey("custom"))
+		})
+
+		It("Clears the tags and custom fields when the attributes are empty", func() {
+			opts.Attributes = &types.Attributes{}
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(HaveKeyWithValue("tags", []interface{}{}))
+			Expect(body.Variables).To(HaveKeyWithValue("custom", map[string]interface{}{}))
+		})
+
+		It("Sends the editSubscription mutation", func() {
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			var body struct {
+				Query string `json:"query"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Query).To(HavePrefix("mutation "))
+			Expect(body.Query).To(ContainSubstring("\n  editSubscription("))
+		})
+
+		It("Returns the updated subscription, including its tags and custom fields", func() {
+			result, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(entity))
+			Expect(result.Tags).To(Equal([]string{"prod"}))
+			Expect(result.Custom).To(Equal(map[string]interface{}{"owner": "edge@example.com"}))
+		})
+
+		It("Requires a name", func() {
+			opts.Name = ""
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).To(MatchError("Must supply a subscription name"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Requires a channel UUID", func() {
+			opts.ChannelUUID = ""
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).To(MatchError("Must supply a channel UUID"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Requires a version UUID", func() {
+			opts.VersionUUID = ""
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).To(MatchError("Must supply a version UUID"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Rejects invalid attributes without sending a request", func() {
+			attrs.Tags = []string{""}
+			_, err := c.EditSubscription(orgID, uuid, opts)
+			Expect(err).To(MatchError("Tags must not be empty"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		Context("When the edit is not successful", func() {
+			BeforeEach(func() {
+				details.Success = false
+				h.DoReturnsOnCall(0, respond(QueryEditSubscription, details), nil)
+			})
+
+			It("Returns an error without fetching the subscription", func() {
+				result, err := c.EditSubscription(orgID, uuid, opts)
+				Expect(err).To(MatchError("Unable to edit subscription subscription-uuid"))
+				Expect(result).To(BeNil())
+				Expect(h.DoCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturnsOnCall(0, nil, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.EditSubscription(orgID, uuid, opts)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				h.DoReturnsOnCall(0, &http.Response{Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes))}, nil)
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				result, err := c.EditSubscription(orgID, uuid, opts)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
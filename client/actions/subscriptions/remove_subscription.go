@@ -2,6 +2,7 @@ package subscriptions
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -39,16 +40,6 @@ func NewRemoveSubscriptionVariables(orgID, uuid string) RemoveSubscriptionVariab
 	return vars
 }
 
-// RemoveSubscriptionResponse is the response body we get upon a successful cluster
-// registration.
-type RemoveSubscriptionResponse struct {
-	Data *RemoveSubscriptionResponseData `json:"data,omitempty"`
-}
-
-type RemoveSubscriptionResponseData struct {
-	Details *RemoveSubscriptionResponseDataDetails `json:"removeSubscription,omitempty"`
-}
-
 type RemoveSubscriptionResponseDataDetails struct {
 	UUID    string `json:"uuid,omitempty"`
 	Success bool   `json:"success,omitempty"`
@@ -56,19 +47,7 @@ type RemoveSubscriptionResponseDataDetails struct {
 
 // RemoveSubscription deletes specified subscription
 func (c *Client) RemoveSubscription(orgID, uuid string) (*RemoveSubscriptionResponseDataDetails, error) {
-	var response RemoveSubscriptionResponse
-
 	vars := NewRemoveSubscriptionVariables(orgID, uuid)
 
-	err := c.DoQuery(RemoveSubscriptionVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*RemoveSubscriptionResponseDataDetails](&c.SatConClient, QueryRemoveSubscription, RemoveSubscriptionVarTemplate, vars, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -62,20 +63,16 @@ var _ = Describe("Removing a Subscription", func() {
 
 	Describe("RemoveSubscription", func() {
 		var (
-			rcResponse RemoveSubscriptionResponse
+			rcResponse *RemoveSubscriptionResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			rcResponse = RemoveSubscriptionResponse{
-				Data: &RemoveSubscriptionResponseData{
-					Details: &RemoveSubscriptionResponseDataDetails{
-						UUID:    "abacab-is-a-genesis-album",
-						Success: true,
-					},
-				},
+			rcResponse = &RemoveSubscriptionResponseDataDetails{
+				UUID:    "abacab-is-a-genesis-album",
+				Success: true,
 			}
 
-			respBodyBytes, err := json.Marshal(rcResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryRemoveSubscription: rcResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -91,7 +88,7 @@ var _ = Describe("Removing a Subscription", func() {
 			details, _ := c.RemoveSubscription(orgID, uuid)
 			Expect(details).NotTo(BeNil())
 
-			expected := rcResponse.Data.Details
+			expected := rcResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -108,13 +105,13 @@ var _ = Describe("Removing a Subscription", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(RemoveSubscriptionResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.RemoveSubscription(orgID, uuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -2,6 +2,7 @@ package subscriptions
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -37,16 +38,6 @@ func NewSetSubscriptionVariables(orgID string, subscriptionUuid string, versionU
 	return vars
 }
 
-// SetSubscriptionResponse for unmarshalling the response data
-type SetSubscriptionResponse struct {
-	Data *SetSubscriptionResponseData `json:"data,omitempty"`
-}
-
-// SetSubscriptionResponseData for unmarshalling response details
-type SetSubscriptionResponseData struct {
-	Details *SetSubscriptionResponseDataDetails `json:"setSubscription,omitempty"`
-}
-
 // SetSubscriptionResponseDataDetails for unmarshalling response uuid
 type SetSubscriptionResponseDataDetails struct {
 	UUID string `json:"uuid,omitempty"`
@@ -54,19 +45,7 @@ type SetSubscriptionResponseDataDetails struct {
 
 // SetSubscription changes a subscription to a new version
 func (c *Client) SetSubscription(orgID string, subscriptionUUID string, versionUUID string) (*SetSubscriptionResponseDataDetails, error) {
-	var response SetSubscriptionResponse
-
 	vars := NewSetSubscriptionVariables(orgID, subscriptionUUID, versionUUID)
 
-	err := c.DoQuery(SetSubscriptionVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, nil
-	}
-
-	return nil, err
+	return web.Do[*SetSubscriptionResponseDataDetails](&c.SatConClient, QuerySetSubscription, SetSubscriptionVarTemplate, vars, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -51,22 +52,18 @@ var _ = Describe("SetSubscription", func() {
 	Describe("SetSubcription", func() {
 
 		var (
-			addSubscriptionResponse SetSubscriptionResponse
+			addSubscriptionResponse *SetSubscriptionResponseDataDetails
 			c                       SubscriptionService
 			httpClient              *webfakes.FakeHTTPClient
 			response                *http.Response
 		)
 
 		BeforeEach(func() {
-			addSubscriptionResponse = SetSubscriptionResponse{
-				Data: &SetSubscriptionResponseData{
-					Details: &SetSubscriptionResponseDataDetails{
-						UUID: "cassini",
-					},
-				},
+			addSubscriptionResponse = &SetSubscriptionResponseDataDetails{
+				UUID: "cassini",
 			}
 
-			respBodyBytes, err := json.Marshal(addSubscriptionResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QuerySetSubscription: addSubscriptionResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -88,7 +85,7 @@ var _ = Describe("SetSubscription", func() {
 
 		It("Returns the uuid from the SetChannelReply", func() {
 			uuid, _ := c.SetSubscription(orgID, subscriptionUuid, versionUuid)
-			expectedUuid := addSubscriptionResponse.Data.Details
+			expectedUuid := addSubscriptionResponse
 			Expect(uuid).To(Equal(expectedUuid))
 			Expect(uuid.UUID).To(MatchRegexp(expectedUuid.UUID))
 		})
@@ -106,13 +103,13 @@ var _ = Describe("SetSubscription", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(SubscriptionsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				uuid, err := c.SetSubscription(orgID, subscriptionUuid, versionUuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(uuid).To(BeNil())
 			})
 		})
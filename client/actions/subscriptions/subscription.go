@@ -0,0 +1,57 @@
+package subscriptions
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QuerySubscription       = "subscription"
+	SubscriptionVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}}{{end}}`
+)
+
+// SubscriptionVariables are the variables used to query a single subscription
+type SubscriptionVariables struct {
+	actions.GraphQLQuery
+	OrgID string
+	UUID  string
+}
+
+// NewSubscriptionVariables returns required query variables
+func NewSubscriptionVariables(orgID, uuid string) SubscriptionVariables {
+	vars := SubscriptionVariables{
+		OrgID: orgID,
+		UUID:  uuid,
+	}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QuerySubscription
+	vars.Args = map[string]string{
+		"orgId": "String!",
+		"uuid":  "String!",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"orgId",
+		"name",
+		"tags",
+		"custom",
+		"groups",
+		"channelName",
+		"channelUuid",
+		"version",
+		"versionUuid",
+		"created",
+		"updated",
+	}
+
+	return vars
+}
+
+// Subscription returns the subscription specified by uuid
+func (c *Client) Subscription(orgID, uuid string) (*types.Subscription, error) {
+	vars := NewSubscriptionVariables(orgID, uuid)
+
+	return web.Do[*types.Subscription](&c.SatConClient, QuerySubscription, SubscriptionVarTemplate, vars, nil)
+}
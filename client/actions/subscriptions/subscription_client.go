@@ -17,7 +17,11 @@ type SubscriptionService interface {
 	SetSubscription(orgID string, subscriptionUuid string, versionUuid string) (*SetSubscriptionResponseDataDetails, error)
 	RemoveSubscription(orgID, uuid string) (*RemoveSubscriptionResponseDataDetails, error)
 	Subscriptions(orgID string) (types.SubscriptionList, error)
+	Subscription(orgID, uuid string) (*types.Subscription, error)
 	SubscriptionIdsForCluster(orgID string, clusterID string) ([]string, error)
+	AddSubscriptionWithOptions(orgID, name, channelUuid, versionUuid string, groups []string, opts AddSubscriptionOptions) (*AddSubscriptionResponseDataDetails, error)
+	EditSubscription(orgID, uuid string, opts EditSubscriptionOptions) (*types.Subscription, error)
+	SubscriptionsByTags(orgID string, tags []string) (types.SubscriptionList, error)
 }
 
 // Client is an implementation of a satcon client.
@@ -3,6 +3,7 @@ package subscriptions
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -39,35 +40,17 @@ func NewSubscriptionIdsForClusterVariables(orgID string, clusterID string) Subsc
 	return vars
 }
 
-//SubscriptionIdsForClusterResponse response from query
-type SubscriptionIdsForClusterResponse struct {
-	Data *SubscriptionIdsForClusterResponseData `json:"data,omitempty"`
-}
-
-// SubscriptionIdsForClusterResponseData data response from query
-type SubscriptionIdsForClusterResponseData struct {
-	SubscriptionIdsForCluster []types.UuidOnly `json:"subscriptionsForCluster,omitempty"`
-}
-
 func (c *Client) SubscriptionIdsForCluster(orgID string, clusterID string) ([]string, error) {
-	var response SubscriptionIdsForClusterResponse
-
 	vars := NewSubscriptionIdsForClusterVariables(orgID, clusterID)
 
-	err := c.DoQuery(SubscriptionIdsForClusterVarTemplate, vars, nil, &response)
-
+	uuidResponses, err := web.Do[[]types.UuidOnly](&c.SatConClient, QuerySubscriptionIdsForCluster, SubscriptionIdsForClusterVarTemplate, vars, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.Data != nil {
-		uuidResponses := response.Data.SubscriptionIdsForCluster
-		uuids := make([]string, len(uuidResponses))
-		for i := 0; i < len(uuidResponses); i++ {
-			uuids[i] = uuidResponses[i].UUID
-		}
-		return uuids, nil
+	uuids := make([]string, len(uuidResponses))
+	for i := 0; i < len(uuidResponses); i++ {
+		uuids[i] = uuidResponses[i].UUID
 	}
-
-	return nil, err
+	return uuids, nil
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -48,7 +49,7 @@ var _ = Describe("SubscriptionIdsForCluster", func() {
 	Describe("SubscriptionIdsForCluster", func() {
 
 		var (
-			subscriptionsResponse SubscriptionIdsForClusterResponse
+			subscriptionsResponse []types.UuidOnly
 			subscriptionIds       []string
 			c                     SubscriptionService
 			httpClient            *webfakes.FakeHTTPClient
@@ -62,17 +63,12 @@ var _ = Describe("SubscriptionIdsForCluster", func() {
 				"9000",
 				"2001",
 			}
-			responseDataField := make([]types.UuidOnly, len(subscriptionIds))
+			subscriptionsResponse = make([]types.UuidOnly, len(subscriptionIds))
 			for i := 0; i < len(subscriptionIds); i++ {
-				responseDataField[i] = types.UuidOnly{UUID: subscriptionIds[i]}
-			}
-			subscriptionsResponse = SubscriptionIdsForClusterResponse{
-				Data: &SubscriptionIdsForClusterResponseData{
-					SubscriptionIdsForCluster: responseDataField,
-				},
+				subscriptionsResponse[i] = types.UuidOnly{UUID: subscriptionIds[i]}
 			}
 
-			respBodyBytes, err := json.Marshal(subscriptionsResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QuerySubscriptionIdsForCluster: subscriptionsResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -110,13 +106,13 @@ var _ = Describe("SubscriptionIdsForCluster", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(SubscriptionIdsForClusterResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				subscriptions, err := c.SubscriptionIdsForCluster(orgID, clusterID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(subscriptions).To(BeNil())
 			})
 		})
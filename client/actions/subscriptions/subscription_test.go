@@ -0,0 +1,129 @@
+package subscriptions_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Subscription", func() {
+	var (
+		orgID          string
+		uuid           string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		uuid = "subscription-uuid"
+	})
+
+	Describe("NewSubscriptionVariables", func() {
+		It("Returns a correctly populated instance of SubscriptionVariables", func() {
+			vars := NewSubscriptionVariables(orgID, uuid)
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(QuerySubscription))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(uuid))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId": "String!",
+				"uuid":  "String!",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"orgId",
+				"name",
+				"tags",
+				"custom",
+				"groups",
+				"channelName",
+				"channelUuid",
+				"version",
+				"versionUuid",
+				"created",
+				"updated",
+			))
+		})
+	})
+
+	Describe("Subscription", func() {
+		var (
+			c                    SubscriptionService
+			h                    *webfakes.FakeHTTPClient
+			response             *http.Response
+			subscriptionResponse *types.Subscription
+		)
+
+		BeforeEach(func() {
+			subscriptionResponse = &types.Subscription{
+				UUID:        types.SubscriptionUUID(uuid),
+				OrgID:       types.OrgID(orgID),
+				Name:        "subscription1",
+				Groups:      []string{"group1"},
+				ChannelUUID: "channel-uuid",
+				VersionUUID: "version-uuid",
+				Attributes: types.Attributes{
+					Tags: []string{"prod"},
+				},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QuerySubscription: subscriptionResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturns(response, nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.Subscription(orgID, uuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the specified subscription", func() {
+			subscription, _ := c.Subscription(orgID, uuid)
+			Expect(subscription).To(Equal(subscriptionResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.Subscription(orgID, uuid)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				subscription, err := c.Subscription(orgID, uuid)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(subscription).To(BeNil())
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package subscriptions
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -32,6 +33,8 @@ func NewSubscriptionsVariables(orgID string) SubscriptionsVariables {
 	vars.Returns = []string{
 		"orgId",
 		"name",
+		"tags",
+		"custom",
 		"uuid",
 		"groups",
 		"channelName",
@@ -42,30 +45,8 @@ func NewSubscriptionsVariables(orgID string) SubscriptionsVariables {
 	return vars
 }
 
-//SubscriptionsResponse response from query
-type SubscriptionsResponse struct {
-	Data *SubscriptionsResponseData `json:"data,omitempty"`
-}
-
-// SubscriptionsResponseData data response from query
-type SubscriptionsResponseData struct {
-	Subscriptions types.SubscriptionList `json:"subscriptions,omitempty"`
-}
-
 func (c *Client) Subscriptions(orgID string) (types.SubscriptionList, error) {
-	var response SubscriptionsResponse
-
 	vars := NewSubscriptionsVariables(orgID)
 
-	err := c.DoQuery(SubscriptionsVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Subscriptions, nil
-	}
-
-	return nil, err
+	return web.Do[types.SubscriptionList](&c.SatConClient, QuerySubscriptions, SubscriptionsVarTemplate, vars, nil)
 }
@@ -0,0 +1,23 @@
+package subscriptions
+
+import (
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// SubscriptionsByTags returns the subscriptions of the organization which have every one of tags.
+// The filtering is done client side, after listing all of the organization's subscriptions.
+func (c *Client) SubscriptionsByTags(orgID string, tags []string) (types.SubscriptionList, error) {
+	list, err := c.Subscriptions(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagged types.SubscriptionList
+	for _, item := range list {
+		if item.HasTags(tags...) {
+			tagged = append(tagged, item)
+		}
+	}
+
+	return tagged, nil
+}
@@ -0,0 +1,77 @@
+package subscriptions_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("SubscriptionsByTags", func() {
+	var (
+		orgID          string
+		c              SubscriptionService
+		h              *webfakes.FakeHTTPClient
+		response       *http.Response
+		list           types.SubscriptionList
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		list = types.SubscriptionList{
+			{UUID: "uuid1", Name: "subscription1", Attributes: types.Attributes{Tags: []string{"prod", "team-edge"}}},
+			{UUID: "uuid2", Name: "subscription2", Attributes: types.Attributes{Tags: []string{"dev", "team-edge"}}},
+			{UUID: "uuid3", Name: "subscription3"},
+		}
+
+		respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QuerySubscriptions: list}})
+		Expect(err).NotTo(HaveOccurred())
+		response = &http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		h.DoReturns(response, nil)
+
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+	})
+
+	It("Returns the subscriptions which have every tag", func() {
+		result, err := c.SubscriptionsByTags(orgID, []string{"team-edge", "prod"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(types.SubscriptionList{list[0]}))
+	})
+
+	It("Returns every subscription when no tags are given", func() {
+		result, err := c.SubscriptionsByTags(orgID, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(list))
+	})
+
+	It("Returns nothing when no subscription matches", func() {
+		result, err := c.SubscriptionsByTags(orgID, []string{"staging"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+
+	Context("When query execution errors", func() {
+		BeforeEach(func() {
+			h.DoReturns(response, errors.New("Kablooie!"))
+		})
+
+		It("Bubbles up the error", func() {
+			_, err := c.SubscriptionsByTags(orgID, []string{"prod"})
+			Expect(err).To(MatchError("Kablooie!"))
+		})
+	})
+})
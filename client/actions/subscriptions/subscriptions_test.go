@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/subscriptions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -40,6 +41,8 @@ var _ = Describe("SubscriptionsByOrgId", func() {
 			Expect(vars.Returns).To(ConsistOf(
 				"orgId",
 				"name",
+				"tags",
+				"custom",
 				"uuid",
 				"groups",
 				"channelName",
@@ -52,7 +55,7 @@ var _ = Describe("SubscriptionsByOrgId", func() {
 	Describe("Subcriptions", func() {
 
 		var (
-			subscriptionsResponse SubscriptionsResponse
+			subscriptionsResponse types.SubscriptionList
 			c                     SubscriptionService
 			httpClient            *webfakes.FakeHTTPClient
 			response              *http.Response
@@ -60,29 +63,25 @@ var _ = Describe("SubscriptionsByOrgId", func() {
 
 		BeforeEach(func() {
 
-			subscriptionsResponse = SubscriptionsResponse{
-				Data: &SubscriptionsResponseData{
-					Subscriptions: types.SubscriptionList{
-						{
-							UUID:  "hal",
-							OrgID: orgID,
-							Name:  "subscription1",
-						},
-						{
-							UUID:  "9000",
-							OrgID: orgID,
-							Name:  "subscription4",
-						},
-						{
-							UUID:  "2001",
-							OrgID: orgID,
-							Name:  "subscription9",
-						},
-					},
+			subscriptionsResponse = types.SubscriptionList{
+				{
+					UUID:  "hal",
+					OrgID: types.OrgID(orgID),
+					Name:  "subscription1",
+				},
+				{
+					UUID:  "9000",
+					OrgID: types.OrgID(orgID),
+					Name:  "subscription4",
+				},
+				{
+					UUID:  "2001",
+					OrgID: types.OrgID(orgID),
+					Name:  "subscription9",
 				},
 			}
 
-			respBodyBytes, err := json.Marshal(subscriptionsResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QuerySubscriptions: subscriptionsResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -104,7 +103,7 @@ var _ = Describe("SubscriptionsByOrgId", func() {
 
 		It("Returns the list of subscriptions", func() {
 			subscriptions, _ := c.Subscriptions(orgID)
-			expected := subscriptionsResponse.Data.Subscriptions
+			expected := subscriptionsResponse
 			Expect(subscriptions).To(Equal(expected))
 		})
 
@@ -121,13 +120,13 @@ var _ = Describe("SubscriptionsByOrgId", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(SubscriptionsResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				subscriptions, err := c.Subscriptions(orgID)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(subscriptions).To(BeNil())
 			})
 		})
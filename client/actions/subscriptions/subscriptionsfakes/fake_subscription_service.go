@@ -26,6 +26,39 @@ type FakeSubscriptionService struct {
 		result1 *subscriptions.AddSubscriptionResponseDataDetails
 		result2 error
 	}
+	AddSubscriptionWithOptionsStub        func(string, string, string, string, []string, subscriptions.AddSubscriptionOptions) (*subscriptions.AddSubscriptionResponseDataDetails, error)
+	addSubscriptionWithOptionsMutex       sync.RWMutex
+	addSubscriptionWithOptionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 []string
+		arg6 subscriptions.AddSubscriptionOptions
+	}
+	addSubscriptionWithOptionsReturns struct {
+		result1 *subscriptions.AddSubscriptionResponseDataDetails
+		result2 error
+	}
+	addSubscriptionWithOptionsReturnsOnCall map[int]struct {
+		result1 *subscriptions.AddSubscriptionResponseDataDetails
+		result2 error
+	}
+	EditSubscriptionStub        func(string, string, subscriptions.EditSubscriptionOptions) (*types.Subscription, error)
+	editSubscriptionMutex       sync.RWMutex
+	editSubscriptionArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 subscriptions.EditSubscriptionOptions
+	}
+	editSubscriptionReturns struct {
+		result1 *types.Subscription
+		result2 error
+	}
+	editSubscriptionReturnsOnCall map[int]struct {
+		result1 *types.Subscription
+		result2 error
+	}
 	RemoveSubscriptionStub        func(string, string) (*subscriptions.RemoveSubscriptionResponseDataDetails, error)
 	removeSubscriptionMutex       sync.RWMutex
 	removeSubscriptionArgsForCall []struct {
@@ -55,6 +88,20 @@ type FakeSubscriptionService struct {
 		result1 *subscriptions.SetSubscriptionResponseDataDetails
 		result2 error
 	}
+	SubscriptionStub        func(string, string) (*types.Subscription, error)
+	subscriptionMutex       sync.RWMutex
+	subscriptionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	subscriptionReturns struct {
+		result1 *types.Subscription
+		result2 error
+	}
+	subscriptionReturnsOnCall map[int]struct {
+		result1 *types.Subscription
+		result2 error
+	}
 	SubscriptionIdsForClusterStub        func(string, string) ([]string, error)
 	subscriptionIdsForClusterMutex       sync.RWMutex
 	subscriptionIdsForClusterArgsForCall []struct {
@@ -82,6 +129,20 @@ type FakeSubscriptionService struct {
 		result1 types.SubscriptionList
 		result2 error
 	}
+	SubscriptionsByTagsStub        func(string, []string) (types.SubscriptionList, error)
+	subscriptionsByTagsMutex       sync.RWMutex
+	subscriptionsByTagsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	subscriptionsByTagsReturns struct {
+		result1 types.SubscriptionList
+		result2 error
+	}
+	subscriptionsByTagsReturnsOnCall map[int]struct {
+		result1 types.SubscriptionList
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -159,6 +220,146 @@ func (fake *FakeSubscriptionService) AddSubscriptionReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSubscriptionService) AddSubscriptionWithOptions(arg1 string, arg2 string, arg3 string, arg4 string, arg5 []string, arg6 subscriptions.AddSubscriptionOptions) (*subscriptions.AddSubscriptionResponseDataDetails, error) {
+	var arg5Copy []string
+	if arg5 != nil {
+		arg5Copy = make([]string, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.addSubscriptionWithOptionsMutex.Lock()
+	ret, specificReturn := fake.addSubscriptionWithOptionsReturnsOnCall[len(fake.addSubscriptionWithOptionsArgsForCall)]
+	fake.addSubscriptionWithOptionsArgsForCall = append(fake.addSubscriptionWithOptionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 []string
+		arg6 subscriptions.AddSubscriptionOptions
+	}{arg1, arg2, arg3, arg4, arg5Copy, arg6})
+	stub := fake.AddSubscriptionWithOptionsStub
+	fakeReturns := fake.addSubscriptionWithOptionsReturns
+	fake.recordInvocation("AddSubscriptionWithOptions", []interface{}{arg1, arg2, arg3, arg4, arg5Copy, arg6})
+	fake.addSubscriptionWithOptionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionService) AddSubscriptionWithOptionsCallCount() int {
+	fake.addSubscriptionWithOptionsMutex.RLock()
+	defer fake.addSubscriptionWithOptionsMutex.RUnlock()
+	return len(fake.addSubscriptionWithOptionsArgsForCall)
+}
+
+func (fake *FakeSubscriptionService) AddSubscriptionWithOptionsCalls(stub func(string, string, string, string, []string, subscriptions.AddSubscriptionOptions) (*subscriptions.AddSubscriptionResponseDataDetails, error)) {
+	fake.addSubscriptionWithOptionsMutex.Lock()
+	defer fake.addSubscriptionWithOptionsMutex.Unlock()
+	fake.AddSubscriptionWithOptionsStub = stub
+}
+
+func (fake *FakeSubscriptionService) AddSubscriptionWithOptionsArgsForCall(i int) (string, string, string, string, []string, subscriptions.AddSubscriptionOptions) {
+	fake.addSubscriptionWithOptionsMutex.RLock()
+	defer fake.addSubscriptionWithOptionsMutex.RUnlock()
+	argsForCall := fake.addSubscriptionWithOptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeSubscriptionService) AddSubscriptionWithOptionsReturns(result1 *subscriptions.AddSubscriptionResponseDataDetails, result2 error) {
+	fake.addSubscriptionWithOptionsMutex.Lock()
+	defer fake.addSubscriptionWithOptionsMutex.Unlock()
+	fake.AddSubscriptionWithOptionsStub = nil
+	fake.addSubscriptionWithOptionsReturns = struct {
+		result1 *subscriptions.AddSubscriptionResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionService) AddSubscriptionWithOptionsReturnsOnCall(i int, result1 *subscriptions.AddSubscriptionResponseDataDetails, result2 error) {
+	fake.addSubscriptionWithOptionsMutex.Lock()
+	defer fake.addSubscriptionWithOptionsMutex.Unlock()
+	fake.AddSubscriptionWithOptionsStub = nil
+	if fake.addSubscriptionWithOptionsReturnsOnCall == nil {
+		fake.addSubscriptionWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 *subscriptions.AddSubscriptionResponseDataDetails
+			result2 error
+		})
+	}
+	fake.addSubscriptionWithOptionsReturnsOnCall[i] = struct {
+		result1 *subscriptions.AddSubscriptionResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionService) EditSubscription(arg1 string, arg2 string, arg3 subscriptions.EditSubscriptionOptions) (*types.Subscription, error) {
+	fake.editSubscriptionMutex.Lock()
+	ret, specificReturn := fake.editSubscriptionReturnsOnCall[len(fake.editSubscriptionArgsForCall)]
+	fake.editSubscriptionArgsForCall = append(fake.editSubscriptionArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 subscriptions.EditSubscriptionOptions
+	}{arg1, arg2, arg3})
+	stub := fake.EditSubscriptionStub
+	fakeReturns := fake.editSubscriptionReturns
+	fake.recordInvocation("EditSubscription", []interface{}{arg1, arg2, arg3})
+	fake.editSubscriptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionService) EditSubscriptionCallCount() int {
+	fake.editSubscriptionMutex.RLock()
+	defer fake.editSubscriptionMutex.RUnlock()
+	return len(fake.editSubscriptionArgsForCall)
+}
+
+func (fake *FakeSubscriptionService) EditSubscriptionCalls(stub func(string, string, subscriptions.EditSubscriptionOptions) (*types.Subscription, error)) {
+	fake.editSubscriptionMutex.Lock()
+	defer fake.editSubscriptionMutex.Unlock()
+	fake.EditSubscriptionStub = stub
+}
+
+func (fake *FakeSubscriptionService) EditSubscriptionArgsForCall(i int) (string, string, subscriptions.EditSubscriptionOptions) {
+	fake.editSubscriptionMutex.RLock()
+	defer fake.editSubscriptionMutex.RUnlock()
+	argsForCall := fake.editSubscriptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSubscriptionService) EditSubscriptionReturns(result1 *types.Subscription, result2 error) {
+	fake.editSubscriptionMutex.Lock()
+	defer fake.editSubscriptionMutex.Unlock()
+	fake.EditSubscriptionStub = nil
+	fake.editSubscriptionReturns = struct {
+		result1 *types.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionService) EditSubscriptionReturnsOnCall(i int, result1 *types.Subscription, result2 error) {
+	fake.editSubscriptionMutex.Lock()
+	defer fake.editSubscriptionMutex.Unlock()
+	fake.EditSubscriptionStub = nil
+	if fake.editSubscriptionReturnsOnCall == nil {
+		fake.editSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 *types.Subscription
+			result2 error
+		})
+	}
+	fake.editSubscriptionReturnsOnCall[i] = struct {
+		result1 *types.Subscription
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSubscriptionService) RemoveSubscription(arg1 string, arg2 string) (*subscriptions.RemoveSubscriptionResponseDataDetails, error) {
 	fake.removeSubscriptionMutex.Lock()
 	ret, specificReturn := fake.removeSubscriptionReturnsOnCall[len(fake.removeSubscriptionArgsForCall)]
@@ -290,6 +491,71 @@ func (fake *FakeSubscriptionService) SetSubscriptionReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSubscriptionService) Subscription(arg1 string, arg2 string) (*types.Subscription, error) {
+	fake.subscriptionMutex.Lock()
+	ret, specificReturn := fake.subscriptionReturnsOnCall[len(fake.subscriptionArgsForCall)]
+	fake.subscriptionArgsForCall = append(fake.subscriptionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SubscriptionStub
+	fakeReturns := fake.subscriptionReturns
+	fake.recordInvocation("Subscription", []interface{}{arg1, arg2})
+	fake.subscriptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionService) SubscriptionCallCount() int {
+	fake.subscriptionMutex.RLock()
+	defer fake.subscriptionMutex.RUnlock()
+	return len(fake.subscriptionArgsForCall)
+}
+
+func (fake *FakeSubscriptionService) SubscriptionCalls(stub func(string, string) (*types.Subscription, error)) {
+	fake.subscriptionMutex.Lock()
+	defer fake.subscriptionMutex.Unlock()
+	fake.SubscriptionStub = stub
+}
+
+func (fake *FakeSubscriptionService) SubscriptionArgsForCall(i int) (string, string) {
+	fake.subscriptionMutex.RLock()
+	defer fake.subscriptionMutex.RUnlock()
+	argsForCall := fake.subscriptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSubscriptionService) SubscriptionReturns(result1 *types.Subscription, result2 error) {
+	fake.subscriptionMutex.Lock()
+	defer fake.subscriptionMutex.Unlock()
+	fake.SubscriptionStub = nil
+	fake.subscriptionReturns = struct {
+		result1 *types.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionService) SubscriptionReturnsOnCall(i int, result1 *types.Subscription, result2 error) {
+	fake.subscriptionMutex.Lock()
+	defer fake.subscriptionMutex.Unlock()
+	fake.SubscriptionStub = nil
+	if fake.subscriptionReturnsOnCall == nil {
+		fake.subscriptionReturnsOnCall = make(map[int]struct {
+			result1 *types.Subscription
+			result2 error
+		})
+	}
+	fake.subscriptionReturnsOnCall[i] = struct {
+		result1 *types.Subscription
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSubscriptionService) SubscriptionIdsForCluster(arg1 string, arg2 string) ([]string, error) {
 	fake.subscriptionIdsForClusterMutex.Lock()
 	ret, specificReturn := fake.subscriptionIdsForClusterReturnsOnCall[len(fake.subscriptionIdsForClusterArgsForCall)]
@@ -419,19 +685,97 @@ func (fake *FakeSubscriptionService) SubscriptionsReturnsOnCall(i int, result1 t
 	}{result1, result2}
 }
 
+func (fake *FakeSubscriptionService) SubscriptionsByTags(arg1 string, arg2 []string) (types.SubscriptionList, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.subscriptionsByTagsMutex.Lock()
+	ret, specificReturn := fake.subscriptionsByTagsReturnsOnCall[len(fake.subscriptionsByTagsArgsForCall)]
+	fake.subscriptionsByTagsArgsForCall = append(fake.subscriptionsByTagsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.SubscriptionsByTagsStub
+	fakeReturns := fake.subscriptionsByTagsReturns
+	fake.recordInvocation("SubscriptionsByTags", []interface{}{arg1, arg2Copy})
+	fake.subscriptionsByTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionService) SubscriptionsByTagsCallCount() int {
+	fake.subscriptionsByTagsMutex.RLock()
+	defer fake.subscriptionsByTagsMutex.RUnlock()
+	return len(fake.subscriptionsByTagsArgsForCall)
+}
+
+func (fake *FakeSubscriptionService) SubscriptionsByTagsCalls(stub func(string, []string) (types.SubscriptionList, error)) {
+	fake.subscriptionsByTagsMutex.Lock()
+	defer fake.subscriptionsByTagsMutex.Unlock()
+	fake.SubscriptionsByTagsStub = stub
+}
+
+func (fake *FakeSubscriptionService) SubscriptionsByTagsArgsForCall(i int) (string, []string) {
+	fake.subscriptionsByTagsMutex.RLock()
+	defer fake.subscriptionsByTagsMutex.RUnlock()
+	argsForCall := fake.subscriptionsByTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSubscriptionService) SubscriptionsByTagsReturns(result1 types.SubscriptionList, result2 error) {
+	fake.subscriptionsByTagsMutex.Lock()
+	defer fake.subscriptionsByTagsMutex.Unlock()
+	fake.SubscriptionsByTagsStub = nil
+	fake.subscriptionsByTagsReturns = struct {
+		result1 types.SubscriptionList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionService) SubscriptionsByTagsReturnsOnCall(i int, result1 types.SubscriptionList, result2 error) {
+	fake.subscriptionsByTagsMutex.Lock()
+	defer fake.subscriptionsByTagsMutex.Unlock()
+	fake.SubscriptionsByTagsStub = nil
+	if fake.subscriptionsByTagsReturnsOnCall == nil {
+		fake.subscriptionsByTagsReturnsOnCall = make(map[int]struct {
+			result1 types.SubscriptionList
+			result2 error
+		})
+	}
+	fake.subscriptionsByTagsReturnsOnCall[i] = struct {
+		result1 types.SubscriptionList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSubscriptionService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addSubscriptionMutex.RLock()
 	defer fake.addSubscriptionMutex.RUnlock()
+	fake.addSubscriptionWithOptionsMutex.RLock()
+	defer fake.addSubscriptionWithOptionsMutex.RUnlock()
+	fake.editSubscriptionMutex.RLock()
+	defer fake.editSubscriptionMutex.RUnlock()
 	fake.removeSubscriptionMutex.RLock()
 	defer fake.removeSubscriptionMutex.RUnlock()
 	fake.setSubscriptionMutex.RLock()
 	defer fake.setSubscriptionMutex.RUnlock()
+	fake.subscriptionMutex.RLock()
+	defer fake.subscriptionMutex.RUnlock()
 	fake.subscriptionIdsForClusterMutex.RLock()
 	defer fake.subscriptionIdsForClusterMutex.RUnlock()
 	fake.subscriptionsMutex.RLock()
 	defer fake.subscriptionsMutex.RUnlock()
+	fake.subscriptionsByTagsMutex.RLock()
+	defer fake.subscriptionsByTagsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
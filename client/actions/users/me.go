@@ -3,6 +3,7 @@ package users
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -34,31 +35,9 @@ func NewMeVariables() MeVariables {
 	return vars
 }
 
-// MeResponse user data
-type MeResponse struct {
-	Data *MeResponseData `json:"data,omitempty"`
-}
-
-// MeResponseData user details
-type MeResponseData struct {
-	User *types.User `json:"me,omitempty"`
-}
-
 // Channel returns channel specified by channeUuid
 func (c *Client) Me() (*types.User, error) {
-	var response MeResponse
-
 	vars := NewMeVariables()
 
-	err := c.DoQuery(MeVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.User, err
-	}
-
-	return nil, err
+	return web.Do[*types.User](&c.SatConClient, QueryMe, MeVarTemplate, vars, nil)
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/users"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -47,24 +48,20 @@ var _ = Describe("Me", func() {
 			c          UserService
 			h          *webfakes.FakeHTTPClient
 			response   *http.Response
-			meResponse MeResponse
+			meResponse *types.User
 		)
 
 		BeforeEach(func() {
-			meResponse = MeResponse{
-				Data: &MeResponseData{
-					User: &types.User{
-						Id:         "1",
-						Type:       "local",
-						OrgId:      "myorg",
-						Identifier: "admin",
-						Email:      "admin@foo.ibm.com",
-						Role:       "admin",
-					},
-				},
+			meResponse = &types.User{
+				Id:         "1",
+				Type:       "local",
+				OrgId:      "myorg",
+				Identifier: "admin",
+				Email:      "admin@foo.ibm.com",
+				Role:       "admin",
 			}
 
-			respBodyBytes, err := json.Marshal(meResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryMe: meResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -85,7 +82,7 @@ var _ = Describe("Me", func() {
 
 		It("Returns the logged in user", func() {
 			channel, _ := c.Me()
-			expected := meResponse.Data.User
+			expected := meResponse
 			Expect(channel).To(Equal(expected))
 		})
 
@@ -102,13 +99,13 @@ var _ = Describe("Me", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(MeResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				channel, err := c.Me()
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(channel).To(BeNil())
 			})
 		})
@@ -2,6 +2,7 @@ package versions
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -53,16 +54,6 @@ func NewAddChannelVersionVariables(orgID, channelUuid, name, contentType string,
 	return vars
 }
 
-// AddChannelVersionResponse for unmarshalling the response data
-type AddChannelVersionResponse struct {
-	Data *AddChannelVersionResponseData `json:"data,omitempty"`
-}
-
-// AddChannelVersionResponseData for unmarshalling response details
-type AddChannelVersionResponseData struct {
-	Details *AddChannelVersionResponseDataDetails `json:"addChannelVersion,omitempty"`
-}
-
 // AddChannelVersionResponseDataDetails for unmarshalling response uuid
 type AddChannelVersionResponseDataDetails struct {
 	VersionUUID string `json:"versionUuid,omitempty"`
@@ -72,19 +63,7 @@ type AddChannelVersionResponseDataDetails struct {
 // AddChannelVersion creates a new channelVersion for valid channel.
 // contentFile is path to yaml file
 func (c *Client) AddChannelVersion(orgID, channelUuid, name string, content []byte, description string) (*AddChannelVersionResponseDataDetails, error) {
-	var response AddChannelVersionResponse
-
 	vars := NewAddChannelVersionVariables(orgID, channelUuid, name, ContentType, string(content), "", description)
 
-	err := c.DoQuery(AddChannelVersionVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, nil
-	}
-
-	return nil, err
+	return web.Do[*AddChannelVersionResponseDataDetails](&c.SatConClient, QueryAddChannelVersion, AddChannelVersionVarTemplate, vars, nil)
 }
@@ -72,20 +72,16 @@ var _ = Describe("AddChannelVersion", func() {
 	Describe("AddChannelVersion", func() {
 
 		var (
-			addChannelVersionResponse AddChannelVersionResponse
+			addChannelVersionResponse *AddChannelVersionResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			addChannelVersionResponse = AddChannelVersionResponse{
-				Data: &AddChannelVersionResponseData{
-					Details: &AddChannelVersionResponseDataDetails{
-						VersionUUID: "newversionuuid",
-						Success:     true,
-					},
-				},
+			addChannelVersionResponse = &AddChannelVersionResponseDataDetails{
+				VersionUUID: "newversionuuid",
+				Success:     true,
 			}
 
-			respBodyBytes, err := json.Marshal(addChannelVersionResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddChannelVersion: addChannelVersionResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -101,7 +97,7 @@ var _ = Describe("AddChannelVersion", func() {
 			details, _ := c.AddChannelVersion(orgID, channelUuid, name, content, description)
 			Expect(details).NotTo(BeNil())
 
-			expected := addChannelVersionResponse.Data.Details
+			expected := addChannelVersionResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -0,0 +1,67 @@
+package versions
+
+import (
+	"errors"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	AddRemoteChannelVersionVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"channelUuid":{{json .ChannelUUID}},"name":{{json .Name}},"type":{{json .ContentType}},"remote":{{json .Remote}},"description":{{json .Description}}{{end}}`
+)
+
+// AddRemoteChannelVersionVariables to create addChannelVersion graphql request
+// for a version of a remote channel
+type AddRemoteChannelVersionVariables struct {
+	actions.GraphQLQuery
+	OrgID       string
+	ChannelUUID string
+	Name        string
+	ContentType string
+	Remote      types.VersionRemote
+	Description string
+}
+
+// NewAddRemoteChannelVersionVariables creates query variable
+func NewAddRemoteChannelVersionVariables(orgID, channelUuid, name string, remote types.VersionRemote, description string) AddRemoteChannelVersionVariables {
+	vars := AddRemoteChannelVersionVariables{
+		OrgID:       orgID,
+		ChannelUUID: channelUuid,
+		Name:        name,
+		ContentType: ContentType,
+		Remote:      remote,
+		Description: description,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryAddChannelVersion
+	vars.Args = map[string]string{
+		"orgId":       "String!",
+		"channelUuid": "String!",
+		"name":        "String!",
+		"type":        "String!",
+		"remote":      "VersionRemoteInput",
+		"description": "String",
+	}
+	vars.Returns = []string{
+		"versionUuid",
+		"success",
+	}
+
+	return vars
+}
+
+// AddRemoteChannelVersion creates a new channelVersion for a remote channel.  Rather
+// than uploading content, the version's remote parameters reference it within the
+// channel's remote, e.g. as a git ref and file path.
+func (c *Client) AddRemoteChannelVersion(orgID, channelUuid, name string, remote types.VersionRemote, description string) (*AddChannelVersionResponseDataDetails, error) {
+	if len(remote.Parameters) == 0 {
+		return nil, errors.New("Must supply at least one remote parameter")
+	}
+
+	vars := NewAddRemoteChannelVersionVariables(orgID, channelUuid, name, remote, description)
+
+	return web.Do[*AddChannelVersionResponseDataDetails](&c.SatConClient, QueryAddChannelVersion, AddRemoteChannelVersionVarTemplate, vars, nil)
+}
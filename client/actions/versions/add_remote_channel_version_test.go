@@ -0,0 +1,157 @@
+package versions_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/versions"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("AddRemoteChannelVersion", func() {
+
+	var (
+		orgID, channelUuid, name string
+		description              string
+		remote                   types.VersionRemote
+		c                        VersionService
+		h                        *webfakes.FakeHTTPClient
+		response                 *http.Response
+		fakeAuthClient           authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		channelUuid = "somechannel"
+		name = "v1.0.0"
+		description = "somedescription"
+		remote = types.VersionRemote{
+			Parameters: []types.ParameterTuple{
+				{Key: "ref", Value: "v1.0.0"},
+				{Key: "filePath", Value: "deploy/*.yaml"},
+			},
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		response = &http.Response{}
+		h.DoReturns(response, nil)
+	})
+
+	JustBeforeEach(func() {
+		c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		Expect(c).NotTo(BeNil())
+	})
+
+	Describe("NewAddRemoteChannelVersionVariables", func() {
+		It("Returns a correctly configured set of variables", func() {
+			vars := NewAddRemoteChannelVersionVariables(orgID, channelUuid, name, remote, description)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryAddChannelVersion))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.ChannelUUID).To(Equal(channelUuid))
+			Expect(vars.Name).To(Equal(name))
+			Expect(vars.ContentType).To(Equal(ContentType))
+			Expect(vars.Remote).To(Equal(remote))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":       "String!",
+				"channelUuid": "String!",
+				"name":        "String!",
+				"type":        "String!",
+				"remote":      "VersionRemoteInput",
+				"description": "String",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"versionUuid",
+				"success",
+			))
+		})
+	})
+
+	Describe("AddRemoteChannelVersion", func() {
+
+		var (
+			addChannelVersionResponse *AddChannelVersionResponseDataDetails
+		)
+
+		BeforeEach(func() {
+			addChannelVersionResponse = &AddChannelVersionResponseDataDetails{
+				VersionUUID: "newversionuuid",
+				Success:     true,
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryAddChannelVersion: addChannelVersionResponse}})
+			Expect(err).NotTo(HaveOccurred())
+
+			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+		})
+
+		It("Sends the remote parameters and no content", func() {
+			_, err := c.AddRemoteChannelVersion(orgID, channelUuid, name, remote, description)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":       orgID,
+				"channelUuid": channelUuid,
+				"name":        name,
+				"type":        ContentType,
+				"description": description,
+				"remote": map[string]interface{}{
+					"parameters": []interface{}{
+						map[string]interface{}{"key": "ref", "value": "v1.0.0"},
+						map[string]interface{}{"key": "filePath", "value": "deploy/*.yaml"},
+					},
+				},
+			}))
+		})
+
+		It("Returns the add channel version details", func() {
+			details, _ := c.AddRemoteChannelVersion(orgID, channelUuid, name, remote, description)
+			Expect(details).To(Equal(addChannelVersionResponse))
+		})
+
+		It("Requires remote parameters", func() {
+			_, err := c.AddRemoteChannelVersion(orgID, channelUuid, name, types.VersionRemote{}, description)
+			Expect(err).To(MatchError("Must supply at least one remote parameter"))
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.AddRemoteChannelVersion(orgID, channelUuid, name, remote, description)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				details, err := c.AddRemoteChannelVersion(orgID, channelUuid, name, remote, description)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(details).To(BeNil())
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package versions
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -44,37 +45,16 @@ func NewChannelVersionVariables(orgID, channelUuid, versionUuid string) ChannelV
 		"type",
 		"description",
 		"content",
+		"remote{parameters{key, value}}",
 		"created",
 	}
 
 	return vars
 }
 
-// ChannelVersionResponse top level response struct
-type ChannelVersionResponse struct {
-	Data *ChannelVersionResponseData `json:"data,omitempty"`
-}
-
-// ChannelVersionResponseData provides response details
-type ChannelVersionResponseData struct {
-	Details *types.DeployableVersion `json:"channelVersion,omitempty"`
-}
-
 // ChannelVersion queries a channel version given orgID, channelUuid, and versionUuid
 func (c *Client) ChannelVersion(orgID, channelUuid, versionUuid string) (*types.DeployableVersion, error) {
-	var response ChannelVersionResponse
-
 	vars := NewChannelVersionVariables(orgID, channelUuid, versionUuid)
 
-	err := c.DoQuery(ChannelVersionVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, nil
-	}
-
-	return nil, err
+	return web.Do[*types.DeployableVersion](&c.SatConClient, QueryChannelVersion, ChannelVersionVarTemplate, vars, nil)
 }
@@ -3,6 +3,7 @@ package versions
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -43,37 +44,16 @@ func NewChannelVersionByNameVariables(orgID, channelName, versionName string) Ch
 		"type",
 		"description",
 		"content",
+		"remote{parameters{key, value}}",
 		"created",
 	}
 
 	return vars
 }
 
-// ChannelVersionByNameResponse top level response struct
-type ChannelVersionByNameResponse struct {
-	Data *ChannelVersionByNameResponseData `json:"data,omitempty"`
-}
-
-// ChannelVersionByNameResponseData provides response details
-type ChannelVersionByNameResponseData struct {
-	Details *types.DeployableVersion `json:"channelVersionByName,omitempty"`
-}
-
 // ChannelVersionByName queries a channel version given orgID, channelName, and versionName
 func (c *Client) ChannelVersionByName(orgID, channelName, versionName string) (*types.DeployableVersion, error) {
-	var response ChannelVersionByNameResponse
-
 	vars := NewChannelVersionByNameVariables(orgID, channelName, versionName)
 
-	err := c.DoQuery(ChannelVersionByNameVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, nil
-	}
-
-	return nil, err
+	return web.Do[*types.DeployableVersion](&c.SatConClient, QueryChannelVersionByName, ChannelVersionByNameVarTemplate, vars, nil)
 }
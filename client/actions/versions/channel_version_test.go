@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -14,6 +15,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/versions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -49,6 +51,7 @@ var _ = Describe("ChannelVersion", func() {
 				"type",
 				"description",
 				"content",
+				"remote{parameters{key, value}}",
 				"created",
 			))
 
@@ -58,30 +61,29 @@ var _ = Describe("ChannelVersion", func() {
 	Describe("ChannelVersion", func() {
 
 		var (
-			channelVersionByNameResponse ChannelVersionResponse
+			channelVersionByNameResponse *types.DeployableVersion
 			c                            VersionService
 			httpClient                   *webfakes.FakeHTTPClient
 			response                     *http.Response
 		)
 
 		BeforeEach(func() {
-			channelVersionByNameResponse = ChannelVersionResponse{
-				&ChannelVersionResponseData{
-					Details: &types.DeployableVersion{
-						OrgID:       "cyborgID",
-						UUID:        "youyouID",
-						ChannelID:   "chanID",
-						ChannelName: "chanName",
-						Name:        "somename",
-						Type:        "sometype",
-						Description: "somedescription",
-						Content:     "somecontent",
-						Created:     "createdToday",
-					},
+			channelVersionByNameResponse = &types.DeployableVersion{
+				OrgID:       "cyborgID",
+				UUID:        "youyouID",
+				ChannelID:   "chanID",
+				ChannelName: "chanName",
+				Name:        "somename",
+				Type:        "sometype",
+				Description: "somedescription",
+				Content:     "somecontent",
+				Remote: &types.VersionRemote{
+					Parameters: []types.ParameterTuple{{Key: "ref", Value: "main"}},
 				},
+				Created: types.Timestamp{Time: time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC)},
 			}
 
-			respBodyBytes, err := json.Marshal(channelVersionByNameResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryChannelVersion: channelVersionByNameResponse}})
 			Expect(err).NotTo(HaveOccurred())
 			response = &http.Response{
 				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
@@ -102,7 +104,7 @@ var _ = Describe("ChannelVersion", func() {
 
 		It("Returns the specified channel version", func() {
 			channelVersion, _ := c.ChannelVersion(orgID, channelUuid, versionUuid)
-			expected := channelVersionByNameResponse.Data.Details
+			expected := channelVersionByNameResponse
 			Expect(channelVersion).To(Equal(expected))
 		})
 
@@ -119,13 +121,13 @@ var _ = Describe("ChannelVersion", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(ChannelVersionResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				channelVersion, err := c.ChannelVersion(orgID, channelUuid, versionUuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(channelVersion).To(BeNil())
 			})
 		})
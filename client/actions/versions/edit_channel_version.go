@@ -0,0 +1,67 @@
+package versions
+
+import (
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryEditChannelVersion       = "editChannelVersion"
+	EditChannelVersionVarTemplate = `{{define "vars"}}"orgId":{{json .OrgID}},"uuid":{{json .UUID}},"description":{{json .Description}}{{end}}`
+)
+
+// EditChannelVersionVariables to create editChannelVersion graphql request
+type EditChannelVersionVariables struct {
+	actions.GraphQLQuery
+	OrgID       string
+	UUID        string
+	Description string
+}
+
+// NewEditChannelVersionVariables creates query variable
+func NewEditChannelVersionVariables(orgID, uuid, description string) EditChannelVersionVariables {
+	vars := EditChannelVersionVariables{
+		OrgID:       orgID,
+		UUID:        uuid,
+		Description: description,
+	}
+
+	vars.Type = actions.QueryTypeMutation
+	vars.QueryName = QueryEditChannelVersion
+	vars.Args = map[string]string{
+		"orgId":       "String!",
+		"uuid":        "String!",
+		"description": "String",
+	}
+	vars.Returns = []string{
+		"uuid",
+		"success",
+	}
+
+	return vars
+}
+
+// EditChannelVersionResponseDataDetails for unmarshalling the edit response
+type EditChannelVersionResponseDataDetails struct {
+	UUID    string `json:"uuid,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// EditChannelVersion updates the description of a channel version and returns the
+// updated version.  The content of a version cannot be changed; add a new version instead.
+func (c *Client) EditChannelVersion(orgID, channelUuid, versionUuid, description string) (*types.DeployableVersion, error) {
+	vars := NewEditChannelVersionVariables(orgID, versionUuid, description)
+
+	details, err := web.Do[*EditChannelVersionResponseDataDetails](&c.SatConClient, QueryEditChannelVersion, EditChannelVersionVarTemplate, vars, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !details.Success {
+		return nil, fmt.Errorf("Unable to edit channel version %s", versionUuid)
+	}
+
+	return c.ChannelVersion(orgID, channelUuid, versionUuid)
+}
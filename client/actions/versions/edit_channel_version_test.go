@@ -0,0 +1,153 @@
+package versions_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/actions/versions"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("EditChannelVersion", func() {
+	var (
+		orgID          string
+		channelUuid    string
+		versionUuid    string
+		description    string
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		channelUuid = "channel-uuid"
+		versionUuid = "version-uuid"
+		description = "new description"
+	})
+
+	Describe("NewEditChannelVersionVariables", func() {
+		It("Returns a correctly populated instance of EditChannelVersionVariables", func() {
+			vars := NewEditChannelVersionVariables(orgID, versionUuid, description)
+			Expect(vars.Type).To(Equal(actions.QueryTypeMutation))
+			Expect(vars.QueryName).To(Equal(QueryEditChannelVersion))
+			Expect(vars.OrgID).To(Equal(orgID))
+			Expect(vars.UUID).To(Equal(versionUuid))
+			Expect(vars.Description).To(Equal(description))
+			Expect(vars.Args).To(Equal(map[string]string{
+				"orgId":       "String!",
+				"uuid":        "String!",
+				"description": "String",
+			}))
+			Expect(vars.Returns).To(ConsistOf(
+				"uuid",
+				"success",
+			))
+		})
+	})
+
+	Describe("EditChannelVersion", func() {
+		var (
+			c       VersionService
+			h       *webfakes.FakeHTTPClient
+			details *EditChannelVersionResponseDataDetails
+			entity  *types.DeployableVersion
+		)
+
+		respond := func(query string, value interface{}) *http.Response {
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{query: value}})
+			Expect(err).NotTo(HaveOccurred())
+			return &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+		}
+
+		BeforeEach(func() {
+			details = &EditChannelVersionResponseDataDetails{
+				UUID:    versionUuid,
+				Success: true,
+			}
+			entity = &types.DeployableVersion{
+				OrgID:       types.OrgID(orgID),
+				UUID:        types.VersionUUID(versionUuid),
+				ChannelID:   types.ChannelUUID(channelUuid),
+				Name:        "v1",
+				Description: description,
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturnsOnCall(0, respond(QueryEditChannelVersion, details), nil)
+			h.DoReturnsOnCall(1, respond(QueryChannelVersion, entity), nil)
+
+			c, _ = NewClient("https://foo.bar", h, &fakeAuthClient)
+		})
+
+		It("Sends the edit and then fetches the version", func() {
+			_, err := c.EditChannelVersion(orgID, channelUuid, versionUuid, description)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(2))
+
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			Expect(json.NewDecoder(h.DoArgsForCall(0).Body).Decode(&body)).To(Succeed())
+			Expect(body.Variables).To(Equal(map[string]interface{}{
+				"orgId":       orgID,
+				"uuid":        versionUuid,
+				"description": description,
+			}))
+		})
+
+		It("Returns the updated version", func() {
+			result, err := c.EditChannelVersion(orgID, channelUuid, versionUuid, description)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(entity))
+		})
+
+		Context("When the edit is not successful", func() {
+			BeforeEach(func() {
+				details.Success = false
+				h.DoReturnsOnCall(0, respond(QueryEditChannelVersion, details), nil)
+			})
+
+			It("Returns an error without fetching the version", func() {
+				result, err := c.EditChannelVersion(orgID, channelUuid, versionUuid, description)
+				Expect(err).To(MatchError("Unable to edit channel version version-uuid"))
+				Expect(result).To(BeNil())
+				Expect(h.DoCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturnsOnCall(0, nil, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.EditChannelVersion(orgID, channelUuid, versionUuid, description)
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				h.DoReturnsOnCall(0, &http.Response{Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes))}, nil)
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				result, err := c.EditChannelVersion(orgID, channelUuid, versionUuid, description)
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+})
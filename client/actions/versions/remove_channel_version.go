@@ -2,6 +2,7 @@ package versions
 
 import (
 	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 const (
@@ -39,35 +40,13 @@ func NewRemoveChannelVersionVariables(orgID, uuid string) RemoveChannelVersionVa
 	return vars
 }
 
-// RemoveChannelVersionResponse is the response body we get upon a successful cluster
-// registration.
-type RemoveChannelVersionResponse struct {
-	Data *RemoveChannelVersionResponseData `json:"data,omitempty"`
-}
-
-type RemoveChannelVersionResponseData struct {
-	Details *RemoveChannelVersionResponseDataDetails `json:"removeChannelVersion,omitempty"`
-}
-
 type RemoveChannelVersionResponseDataDetails struct {
 	UUID    string `json:"uuid,omitempty"`
 	Success bool   `json:"success,omitempty"`
 }
 
 func (c *Client) RemoveChannelVersion(orgID, uuid string) (*RemoveChannelVersionResponseDataDetails, error) {
-	var response RemoveChannelVersionResponse
-
 	vars := NewRemoveChannelVersionVariables(orgID, uuid)
 
-	err := c.DoQuery(RemoveChannelVersionVarTemplate, vars, nil, &response)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Data != nil {
-		return response.Data.Details, err
-	}
-
-	return nil, err
+	return web.Do[*RemoveChannelVersionResponseDataDetails](&c.SatConClient, QueryRemoveChannelVersion, RemoveChannelVersionVarTemplate, vars, nil)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/IBM/satcon-client-go/client/actions"
 	. "github.com/IBM/satcon-client-go/client/actions/versions"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -62,20 +63,16 @@ var _ = Describe("RemoveChannelVersion", func() {
 
 	Describe("RemoveChannelVersion", func() {
 		var (
-			rcResponse RemoveChannelVersionResponse
+			rcResponse *RemoveChannelVersionResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			rcResponse = RemoveChannelVersionResponse{
-				Data: &RemoveChannelVersionResponseData{
-					Details: &RemoveChannelVersionResponseDataDetails{
-						UUID:    "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
-						Success: true,
-					},
-				},
+			rcResponse = &RemoveChannelVersionResponseDataDetails{
+				UUID:    "abcdabcd-abcd-abcd-abcd-abcdabcdabcd",
+				Success: true,
 			}
 
-			respBodyBytes, err := json.Marshal(rcResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{QueryRemoveChannelVersion: rcResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -91,7 +88,7 @@ var _ = Describe("RemoveChannelVersion", func() {
 			details, _ := c.RemoveChannelVersion(orgID, uuid)
 			Expect(details).NotTo(BeNil())
 
-			expected := rcResponse.Data.Details
+			expected := rcResponse
 			Expect(*details).To(Equal(*expected))
 		})
 
@@ -108,13 +105,13 @@ var _ = Describe("RemoveChannelVersion", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(RemoveChannelVersionResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := c.RemoveChannelVersion(orgID, uuid)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -14,9 +14,11 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . VersionService
 type VersionService interface {
 	AddChannelVersion(orgId, channelUuid, name string, content []byte, description string) (*AddChannelVersionResponseDataDetails, error)
+	AddRemoteChannelVersion(orgID, channelUuid, name string, remote types.VersionRemote, description string) (*AddChannelVersionResponseDataDetails, error)
 	RemoveChannelVersion(orgId, uuid string) (*RemoveChannelVersionResponseDataDetails, error)
 	ChannelVersion(orgID, channelUuid, versionUuid string) (*types.DeployableVersion, error)
 	ChannelVersionByName(orgID, channelName, versionName string) (*types.DeployableVersion, error)
+	EditChannelVersion(orgID, channelUuid, versionUuid, description string) (*types.DeployableVersion, error)
 }
 
 // Client is an implementation of a satcon client.
@@ -26,6 +26,23 @@ type FakeVersionService struct {
 		result1 *versions.AddChannelVersionResponseDataDetails
 		result2 error
 	}
+	AddRemoteChannelVersionStub        func(string, string, string, types.VersionRemote, string) (*versions.AddChannelVersionResponseDataDetails, error)
+	addRemoteChannelVersionMutex       sync.RWMutex
+	addRemoteChannelVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 types.VersionRemote
+		arg5 string
+	}
+	addRemoteChannelVersionReturns struct {
+		result1 *versions.AddChannelVersionResponseDataDetails
+		result2 error
+	}
+	addRemoteChannelVersionReturnsOnCall map[int]struct {
+		result1 *versions.AddChannelVersionResponseDataDetails
+		result2 error
+	}
 	ChannelVersionStub        func(string, string, string) (*types.DeployableVersion, error)
 	channelVersionMutex       sync.RWMutex
 	channelVersionArgsForCall []struct {
@@ -56,6 +73,22 @@ type FakeVersionService struct {
 		result1 *types.DeployableVersion
 		result2 error
 	}
+	EditChannelVersionStub        func(string, string, string, string) (*types.DeployableVersion, error)
+	editChannelVersionMutex       sync.RWMutex
+	editChannelVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	editChannelVersionReturns struct {
+		result1 *types.DeployableVersion
+		result2 error
+	}
+	editChannelVersionReturnsOnCall map[int]struct {
+		result1 *types.DeployableVersion
+		result2 error
+	}
 	RemoveChannelVersionStub        func(string, string) (*versions.RemoveChannelVersionResponseDataDetails, error)
 	removeChannelVersionMutex       sync.RWMutex
 	removeChannelVersionArgsForCall []struct {
@@ -147,6 +180,74 @@ func (fake *FakeVersionService) AddChannelVersionReturnsOnCall(i int, result1 *v
 	}{result1, result2}
 }
 
+func (fake *FakeVersionService) AddRemoteChannelVersion(arg1 string, arg2 string, arg3 string, arg4 types.VersionRemote, arg5 string) (*versions.AddChannelVersionResponseDataDetails, error) {
+	fake.addRemoteChannelVersionMutex.Lock()
+	ret, specificReturn := fake.addRemoteChannelVersionReturnsOnCall[len(fake.addRemoteChannelVersionArgsForCall)]
+	fake.addRemoteChannelVersionArgsForCall = append(fake.addRemoteChannelVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 types.VersionRemote
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.AddRemoteChannelVersionStub
+	fakeReturns := fake.addRemoteChannelVersionReturns
+	fake.recordInvocation("AddRemoteChannelVersion", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.addRemoteChannelVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVersionService) AddRemoteChannelVersionCallCount() int {
+	fake.addRemoteChannelVersionMutex.RLock()
+	defer fake.addRemoteChannelVersionMutex.RUnlock()
+	return len(fake.addRemoteChannelVersionArgsForCall)
+}
+
+func (fake *FakeVersionService) AddRemoteChannelVersionCalls(stub func(string, string, string, types.VersionRemote, string) (*versions.AddChannelVersionResponseDataDetails, error)) {
+	fake.addRemoteChannelVersionMutex.Lock()
+	defer fake.addRemoteChannelVersionMutex.Unlock()
+	fake.AddRemoteChannelVersionStub = stub
+}
+
+func (fake *FakeVersionService) AddRemoteChannelVersionArgsForCall(i int) (string, string, string, types.VersionRemote, string) {
+	fake.addRemoteChannelVersionMutex.RLock()
+	defer fake.addRemoteChannelVersionMutex.RUnlock()
+	argsForCall := fake.addRemoteChannelVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeVersionService) AddRemoteChannelVersionReturns(result1 *versions.AddChannelVersionResponseDataDetails, result2 error) {
+	fake.addRemoteChannelVersionMutex.Lock()
+	defer fake.addRemoteChannelVersionMutex.Unlock()
+	fake.AddRemoteChannelVersionStub = nil
+	fake.addRemoteChannelVersionReturns = struct {
+		result1 *versions.AddChannelVersionResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVersionService) AddRemoteChannelVersionReturnsOnCall(i int, result1 *versions.AddChannelVersionResponseDataDetails, result2 error) {
+	fake.addRemoteChannelVersionMutex.Lock()
+	defer fake.addRemoteChannelVersionMutex.Unlock()
+	fake.AddRemoteChannelVersionStub = nil
+	if fake.addRemoteChannelVersionReturnsOnCall == nil {
+		fake.addRemoteChannelVersionReturnsOnCall = make(map[int]struct {
+			result1 *versions.AddChannelVersionResponseDataDetails
+			result2 error
+		})
+	}
+	fake.addRemoteChannelVersionReturnsOnCall[i] = struct {
+		result1 *versions.AddChannelVersionResponseDataDetails
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVersionService) ChannelVersion(arg1 string, arg2 string, arg3 string) (*types.DeployableVersion, error) {
 	fake.channelVersionMutex.Lock()
 	ret, specificReturn := fake.channelVersionReturnsOnCall[len(fake.channelVersionArgsForCall)]
@@ -279,6 +380,73 @@ func (fake *FakeVersionService) ChannelVersionByNameReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeVersionService) EditChannelVersion(arg1 string, arg2 string, arg3 string, arg4 string) (*types.DeployableVersion, error) {
+	fake.editChannelVersionMutex.Lock()
+	ret, specificReturn := fake.editChannelVersionReturnsOnCall[len(fake.editChannelVersionArgsForCall)]
+	fake.editChannelVersionArgsForCall = append(fake.editChannelVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EditChannelVersionStub
+	fakeReturns := fake.editChannelVersionReturns
+	fake.recordInvocation("EditChannelVersion", []interface{}{arg1, arg2, arg3, arg4})
+	fake.editChannelVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVersionService) EditChannelVersionCallCount() int {
+	fake.editChannelVersionMutex.RLock()
+	defer fake.editChannelVersionMutex.RUnlock()
+	return len(fake.editChannelVersionArgsForCall)
+}
+
+func (fake *FakeVersionService) EditChannelVersionCalls(stub func(string, string, string, string) (*types.DeployableVersion, error)) {
+	fake.editChannelVersionMutex.Lock()
+	defer fake.editChannelVersionMutex.Unlock()
+	fake.EditChannelVersionStub = stub
+}
+
+func (fake *FakeVersionService) EditChannelVersionArgsForCall(i int) (string, string, string, string) {
+	fake.editChannelVersionMutex.RLock()
+	defer fake.editChannelVersionMutex.RUnlock()
+	argsForCall := fake.editChannelVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeVersionService) EditChannelVersionReturns(result1 *types.DeployableVersion, result2 error) {
+	fake.editChannelVersionMutex.Lock()
+	defer fake.editChannelVersionMutex.Unlock()
+	fake.EditChannelVersionStub = nil
+	fake.editChannelVersionReturns = struct {
+		result1 *types.DeployableVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVersionService) EditChannelVersionReturnsOnCall(i int, result1 *types.DeployableVersion, result2 error) {
+	fake.editChannelVersionMutex.Lock()
+	defer fake.editChannelVersionMutex.Unlock()
+	fake.EditChannelVersionStub = nil
+	if fake.editChannelVersionReturnsOnCall == nil {
+		fake.editChannelVersionReturnsOnCall = make(map[int]struct {
+			result1 *types.DeployableVersion
+			result2 error
+		})
+	}
+	fake.editChannelVersionReturnsOnCall[i] = struct {
+		result1 *types.DeployableVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVersionService) RemoveChannelVersion(arg1 string, arg2 string) (*versions.RemoveChannelVersionResponseDataDetails, error) {
 	fake.removeChannelVersionMutex.Lock()
 	ret, specificReturn := fake.removeChannelVersionReturnsOnCall[len(fake.removeChannelVersionArgsForCall)]
@@ -349,10 +517,14 @@ func (fake *FakeVersionService) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addChannelVersionMutex.RLock()
 	defer fake.addChannelVersionMutex.RUnlock()
+	fake.addRemoteChannelVersionMutex.RLock()
+	defer fake.addRemoteChannelVersionMutex.RUnlock()
 	fake.channelVersionMutex.RLock()
 	defer fake.channelVersionMutex.RUnlock()
 	fake.channelVersionByNameMutex.RLock()
 	defer fake.channelVersionByNameMutex.RUnlock()
+	fake.editChannelVersionMutex.RLock()
+	defer fake.editChannelVersionMutex.RUnlock()
 	fake.removeChannelVersionMutex.RLock()
 	defer fake.removeChannelVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,38 @@
+package bearer
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+)
+
+// AuthorizationHeaderKey is the header which carries the bearer token
+const AuthorizationHeaderKey = "Authorization"
+
+// StaticTokenClient authenticates requests with a fixed bearer token obtained
+// elsewhere, e.g. handed to a CI job by another system.  The token is never
+// refreshed; once it expires requests will be rejected.
+type StaticTokenClient struct {
+	token string
+}
+
+// Authenticate adds the bearer token to the request
+func (s *StaticTokenClient) Authenticate(request *http.Request) error {
+	request.Header.Set(AuthorizationHeaderKey, "Bearer "+s.token)
+	return nil
+}
+
+// Identity decodes the claims of the token, which must be a JWT
+func (s *StaticTokenClient) Identity() (*auth.Identity, error) {
+	return auth.IdentityFromToken(s.token)
+}
+
+// NewClient returns a StaticTokenClient for the given token
+func NewClient(token string) (*StaticTokenClient, error) {
+	if token == "" {
+		return nil, errors.New("Must supply a token")
+	}
+
+	return &StaticTokenClient{token: token}, nil
+}
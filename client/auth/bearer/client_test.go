@@ -0,0 +1,39 @@
+package bearer_test
+
+import (
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth/bearer"
+)
+
+var _ = Describe("StaticTokenClient", func() {
+	It("Adds the token to the request", func() {
+		client, err := bearer.NewClient("some-token")
+		Expect(err).NotTo(HaveOccurred())
+
+		request := http.Request{Header: http.Header{}}
+		Expect(client.Authenticate(&request)).To(Succeed())
+		Expect(request.Header.Get(bearer.AuthorizationHeaderKey)).To(Equal("Bearer some-token"))
+	})
+
+	It("Decodes the identity from the token", func() {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"org_id": "org-id"}).SignedString([]byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		client, err := bearer.NewClient(token)
+		Expect(err).NotTo(HaveOccurred())
+
+		identity, err := client.Identity()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity.OrgID).To(Equal("org-id"))
+	})
+
+	It("Errors when the token is empty", func() {
+		client, err := bearer.NewClient("")
+		Expect(err).To(MatchError("Must supply a token"))
+		Expect(client).To(BeNil())
+	})
+})
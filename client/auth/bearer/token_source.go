@@ -0,0 +1,126 @@
+package bearer
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+)
+
+// ExpiryDelta is how long before its expiry a token is considered expired, so that
+// it does not run out while a request is in flight.
+var ExpiryDelta = 10 * time.Second
+
+// Token is a bearer token along with when it expires.  A zero Expiry means the
+// token does not expire.
+type Token struct {
+	AccessToken string
+	// TokenType defaults to "Bearer" when empty
+	TokenType string
+	Expiry    time.Time
+}
+
+// valid reports whether the token can still be used
+func (t *Token) valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(ExpiryDelta).Before(t.Expiry)
+}
+
+// TokenSource supplies tokens.  It has the same shape as golang.org/x/oauth2's
+// TokenSource, which can be adapted with a TokenSourceFunc:
+//
+//	bearer.TokenSourceFunc(func() (*bearer.Token, error) {
+//		t, err := oauth2Source.Token()
+//		if err != nil {
+//			return nil, err
+//		}
+//		return &bearer.Token{AccessToken: t.AccessToken, TokenType: t.Type(), Expiry: t.Expiry}, nil
+//	})
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// TokenSourceFunc adapts an ordinary function to a TokenSource
+type TokenSourceFunc func() (*Token, error)
+
+// Token calls f
+func (f TokenSourceFunc) Token() (*Token, error) {
+	return f()
+}
+
+// TokenSourceClient authenticates requests with tokens from a TokenSource.  The
+// current token is cached until shortly before it expires.  It is safe to call
+// from multiple goroutines.
+type TokenSourceClient struct {
+	source TokenSource
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewTokenSourceClient returns a TokenSourceClient which gets its tokens from source
+func NewTokenSourceClient(source TokenSource) (*TokenSourceClient, error) {
+	if source == nil {
+		return nil, errors.New("Must supply a token source")
+	}
+
+	return &TokenSourceClient{source: source}, nil
+}
+
+// Authenticate adds the current token to the request, fetching a new one from the
+// token source if the cached token has expired.
+func (t *TokenSourceClient) Authenticate(request *http.Request) error {
+	token, err := t.currentToken()
+	if err != nil {
+		return err
+	}
+
+	tokenType := token.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	request.Header.Set(AuthorizationHeaderKey, tokenType+" "+token.AccessToken)
+	return nil
+}
+
+// Identity decodes the claims of the current token, which must be a JWT
+func (t *TokenSourceClient) Identity() (*auth.Identity, error) {
+	token, err := t.currentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.IdentityFromToken(token.AccessToken)
+}
+
+// Invalidate discards the cached token, so the next call to Authenticate asks the
+// token source for a new one.
+func (t *TokenSourceClient) Invalidate() {
+	t.mu.Lock()
+	t.token = nil
+	t.mu.Unlock()
+}
+
+func (t *TokenSourceClient) currentToken() (*Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token.valid() {
+		return t.token, nil
+	}
+
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.AccessToken == "" {
+		return nil, errors.New("Token source returned an empty token")
+	}
+
+	t.token = token
+	return token, nil
+}
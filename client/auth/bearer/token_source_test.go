@@ -0,0 +1,106 @@
+package bearer_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/bearer"
+)
+
+var _ = Describe("TokenSourceClient", func() {
+	var (
+		calls  int
+		expiry time.Time
+		source bearer.TokenSourceFunc
+	)
+
+	BeforeEach(func() {
+		calls = 0
+		expiry = time.Now().Add(time.Hour)
+		source = func() (*bearer.Token, error) {
+			calls++
+			return &bearer.Token{AccessToken: fmt.Sprintf("token-%d", calls), Expiry: expiry}, nil
+		}
+	})
+
+	authenticate := func(client auth.AuthClient) string {
+		request := http.Request{Header: http.Header{}}
+		Expect(client.Authenticate(&request)).To(Succeed())
+		return request.Header.Get(bearer.AuthorizationHeaderKey)
+	}
+
+	It("Adds the token from the source to the request", func() {
+		client, err := bearer.NewTokenSourceClient(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authenticate(client)).To(Equal("Bearer token-1"))
+	})
+
+	It("Caches the token until it expires", func() {
+		client, _ := bearer.NewTokenSourceClient(source)
+		authenticate(client)
+		authenticate(client)
+		Expect(calls).To(Equal(1))
+	})
+
+	It("Caches tokens without an expiry indefinitely", func() {
+		expiry = time.Time{}
+		client, _ := bearer.NewTokenSourceClient(source)
+		authenticate(client)
+		authenticate(client)
+		Expect(calls).To(Equal(1))
+	})
+
+	It("Fetches a new token once the cached one is about to expire", func() {
+		expiry = time.Now().Add(bearer.ExpiryDelta / 2)
+		client, _ := bearer.NewTokenSourceClient(source)
+		Expect(authenticate(client)).To(Equal("Bearer token-1"))
+		Expect(authenticate(client)).To(Equal("Bearer token-2"))
+	})
+
+	It("Fetches a new token after Invalidate", func() {
+		client, _ := bearer.NewTokenSourceClient(source)
+		authenticate(client)
+		client.Invalidate()
+		Expect(authenticate(client)).To(Equal("Bearer token-2"))
+	})
+
+	It("Uses the token type supplied by the source", func() {
+		client, _ := bearer.NewTokenSourceClient(bearer.TokenSourceFunc(func() (*bearer.Token, error) {
+			return &bearer.Token{AccessToken: "abc", TokenType: "MAC"}, nil
+		}))
+		Expect(authenticate(client)).To(Equal("MAC abc"))
+	})
+
+	It("Bubbles up errors from the source", func() {
+		client, _ := bearer.NewTokenSourceClient(bearer.TokenSourceFunc(func() (*bearer.Token, error) {
+			return nil, errors.New("no token for you")
+		}))
+		request := http.Request{Header: http.Header{}}
+		Expect(client.Authenticate(&request)).To(MatchError("no token for you"))
+	})
+
+	It("Errors when the source returns an empty token", func() {
+		client, _ := bearer.NewTokenSourceClient(bearer.TokenSourceFunc(func() (*bearer.Token, error) {
+			return &bearer.Token{}, nil
+		}))
+		request := http.Request{Header: http.Header{}}
+		Expect(client.Authenticate(&request)).To(HaveOccurred())
+	})
+
+	It("Errors when no source is supplied", func() {
+		client, err := bearer.NewTokenSourceClient(nil)
+		Expect(err).To(HaveOccurred())
+		Expect(client).To(BeNil())
+	})
+
+	It("Is a RefreshableAuthClient", func() {
+		client, _ := bearer.NewTokenSourceClient(source)
+		var _ auth.RefreshableAuthClient = client
+	})
+})
@@ -6,3 +6,12 @@ import "net/http"
 type AuthClient interface {
 	Authenticate(request *http.Request) error
 }
+
+// RefreshableAuthClient is an AuthClient whose cached credentials can be thrown
+// away, e.g. because the server rejected them.  The next call to Authenticate
+// then fetches fresh credentials.
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RefreshableAuthClient
+type RefreshableAuthClient interface {
+	AuthClient
+	Invalidate()
+}
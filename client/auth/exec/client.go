@@ -0,0 +1,127 @@
+// Package exec provides an auth client which obtains its bearer token by running an
+// external command, in the manner of kubectl's exec credential plugins.  This lets
+// vault, SSO or other credential helpers be plugged in without linking them in.
+//
+// The command must print an ExecCredential to stdout, e.g.:
+//
+//	{
+//	  "kind": "ExecCredential",
+//	  "status": {
+//	    "token": "my-bearer-token",
+//	    "expirationTimestamp": "2022-01-01T00:00:00Z"
+//	  }
+//	}
+//
+// The token is cached until its expirationTimestamp, or until the server rejects it
+// if no expirationTimestamp is given.  Anything the command prints to stderr is
+// included in the error if it fails.
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	osexec "os/exec"
+	"strings"
+	"time"
+
+	"github.com/IBM/satcon-client-go/client/auth/bearer"
+)
+
+// DefaultTimeout is how long the command may run when Config.Timeout is not set
+const DefaultTimeout = time.Minute
+
+// Config describes the credential command to run
+type Config struct {
+	// Command is the executable to run, either an absolute path or looked up in PATH
+	Command string
+	Args    []string
+	// Env is added to the environment inherited from the current process
+	Env     map[string]string
+	Timeout time.Duration
+}
+
+// ExecCredential is what the command prints to stdout
+type ExecCredential struct {
+	APIVersion string                `json:"apiVersion,omitempty"`
+	Kind       string                `json:"kind,omitempty"`
+	Status     *ExecCredentialStatus `json:"status"`
+}
+
+// ExecCredentialStatus holds the token and, optionally, when it expires
+type ExecCredentialStatus struct {
+	Token               string     `json:"token"`
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+}
+
+// TokenSource is a bearer.TokenSource which runs the configured command every time
+// a token is requested.  Use NewClient for a client which caches the token.
+type TokenSource struct {
+	config Config
+}
+
+// NewTokenSource returns a TokenSource which runs the command described by config
+func NewTokenSource(config Config) (*TokenSource, error) {
+	if config.Command == "" {
+		return nil, errors.New("Must supply a command")
+	}
+
+	return &TokenSource{config: config}, nil
+}
+
+// NewClient returns an auth client which authenticates requests with the token printed
+// by the command described by config, running the command again once the token expires.
+func NewClient(config Config) (*bearer.TokenSourceClient, error) {
+	source, err := NewTokenSource(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return bearer.NewTokenSourceClient(source)
+}
+
+// Token runs the command and parses the token from its output
+func (t *TokenSource) Token() (*bearer.Token, error) {
+	timeout := t.config.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := osexec.CommandContext(ctx, t.config.Command, t.config.Args...)
+	// Don't wait on children of the command which still hold its output open
+	cmd.WaitDelay = time.Second
+	cmd.Env = os.Environ()
+	for name, value := range t.config.Env {
+		cmd.Env = append(cmd.Env, name+"="+value)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("Credential command %s failed: %s: %s", t.config.Command, err, msg)
+		}
+		return nil, fmt.Errorf("Credential command %s failed: %s", t.config.Command, err)
+	}
+
+	var credential ExecCredential
+	if err := json.Unmarshal(stdout.Bytes(), &credential); err != nil {
+		return nil, fmt.Errorf("Unable to parse output of credential command %s: %s", t.config.Command, err)
+	}
+	if credential.Status == nil || credential.Status.Token == "" {
+		return nil, fmt.Errorf("Credential command %s did not return a token", t.config.Command)
+	}
+
+	token := &bearer.Token{AccessToken: credential.Status.Token}
+	if credential.Status.ExpirationTimestamp != nil {
+		token.Expiry = *credential.Status.ExpirationTimestamp
+	}
+	return token, nil
+}
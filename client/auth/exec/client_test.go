@@ -0,0 +1,132 @@
+package exec_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth/bearer"
+	"github.com/IBM/satcon-client-go/client/auth/exec"
+)
+
+var _ = Describe("Exec credential plugin", func() {
+	var (
+		config    exec.Config
+		countFile string
+	)
+
+	// script returns a config which runs the shell script, counting its runs in countFile
+	script := func(body string) exec.Config {
+		return exec.Config{
+			Command: "sh",
+			Args:    []string{"-c", `echo run >> "$COUNT_FILE"; ` + body},
+			Env:     map[string]string{"COUNT_FILE": countFile},
+		}
+	}
+
+	runs := func() int {
+		contents, err := os.ReadFile(countFile)
+		if os.IsNotExist(err) {
+			return 0
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return len(contents) / len("run\n")
+	}
+
+	authenticate := func(config exec.Config) (string, error) {
+		client, err := exec.NewClient(config)
+		Expect(err).NotTo(HaveOccurred())
+		request := http.Request{Header: http.Header{}}
+		err = client.Authenticate(&request)
+		return request.Header.Get(bearer.AuthorizationHeaderKey), err
+	}
+
+	BeforeEach(func() {
+		countFile = filepath.Join(GinkgoT().TempDir(), "count")
+		config = script(`echo '{"kind": "ExecCredential", "status": {"token": "my-token"}}'`)
+	})
+
+	It("Authenticates with the token printed by the command", func() {
+		header, err := authenticate(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header).To(Equal("Bearer my-token"))
+	})
+
+	It("Passes environment variables through to the command", func() {
+		config = script(`echo "{\"status\": {\"token\": \"$MY_TOKEN\"}}"`)
+		config.Env["MY_TOKEN"] = "from-env"
+		header, err := authenticate(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header).To(Equal("Bearer from-env"))
+	})
+
+	It("Caches the token until it expires", func() {
+		expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		config = script(`echo '{"status": {"token": "my-token", "expirationTimestamp": "` + expiry + `"}}'`)
+		client, err := exec.NewClient(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		for i := 0; i < 3; i++ {
+			request := http.Request{Header: http.Header{}}
+			Expect(client.Authenticate(&request)).To(Succeed())
+		}
+		Expect(runs()).To(Equal(1))
+	})
+
+	It("Runs the command again once the token has expired", func() {
+		expiry := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+		config = script(`echo '{"status": {"token": "my-token", "expirationTimestamp": "` + expiry + `"}}'`)
+		client, err := exec.NewClient(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		for i := 0; i < 2; i++ {
+			request := http.Request{Header: http.Header{}}
+			Expect(client.Authenticate(&request)).To(Succeed())
+		}
+		Expect(runs()).To(Equal(2))
+	})
+
+	It("Parses the expiry", func() {
+		config = script(`echo '{"status": {"token": "my-token", "expirationTimestamp": "2030-01-02T03:04:05Z"}}'`)
+		source, err := exec.NewTokenSource(config)
+		Expect(err).NotTo(HaveOccurred())
+		token, err := source.Token()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.Expiry).To(BeTemporally("==", time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)))
+	})
+
+	It("Includes stderr in the error when the command fails", func() {
+		config = script(`echo "vault is sealed" >&2; exit 1`)
+		_, err := authenticate(config)
+		Expect(err).To(MatchError(ContainSubstring("vault is sealed")))
+	})
+
+	It("Errors when the output is not JSON", func() {
+		config = script(`echo "not json"`)
+		_, err := authenticate(config)
+		Expect(err).To(MatchError(ContainSubstring("Unable to parse output")))
+	})
+
+	It("Errors when the output has no token", func() {
+		config = script(`echo '{"status": {}}'`)
+		_, err := authenticate(config)
+		Expect(err).To(MatchError(ContainSubstring("did not return a token")))
+	})
+
+	It("Errors when the command times out", func() {
+		config = script(`sleep 5`)
+		config.Timeout = 50 * time.Millisecond
+		_, err := authenticate(config)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Errors when no command is supplied", func() {
+		client, err := exec.NewClient(exec.Config{})
+		Expect(err).To(MatchError("Must supply a command"))
+		Expect(client).To(BeNil())
+	})
+})
@@ -1,6 +1,9 @@
 package iam
 
 import (
+	"net/http"
+	"sync"
+
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/satcon-client-go/client/auth"
 )
@@ -8,17 +11,72 @@ import (
 //Client manages authorization for Satcon Client requests
 type Client struct {
 	Client auth.AuthClient
+
+	// mu guards Client against being swapped out by Invalidate
+	mu sync.RWMutex
+	// newAuthenticator builds a fresh authenticator, discarding any cached token
+	newAuthenticator func() (auth.AuthClient, error)
 }
 
 //NewIAMClient returns a new core.IamAuthenticator struct and also returns the error
 func NewIAMClient(apiKey string, url string) (*Client, error) {
+	return newClient(func() (auth.AuthClient, error) {
+		return core.NewIamAuthenticator(apiKey, url, "", "", false, nil)
+	})
+}
+
+// newClient returns a Client whose authenticator is built, and later rebuilt, by newAuthenticator.
+func newClient(newAuthenticator func() (auth.AuthClient, error)) (*Client, error) {
+	authenticator, err := newAuthenticator()
+	if err != nil {
+		return nil, err
+	}
 
-	iamClient, err := core.NewIamAuthenticator(apiKey, url, "", "", false, nil)
+	return &Client{Client: authenticator, newAuthenticator: newAuthenticator}, nil
+}
+
+//Authenticate adds the IAM bearer token to the request, making *Client itself an auth.AuthClient
+func (c *Client) Authenticate(request *http.Request) error {
+	c.mu.RLock()
+	authenticator := c.Client
+	c.mu.RUnlock()
+
+	return authenticator.Authenticate(request)
+}
 
-	if err == nil {
-		return &Client{Client: iamClient}, nil
+//Identity decodes the claims of the current IAM token, fetching one first if needed.
+//The OrgID of the identity is the IBM Cloud account ID.
+func (c *Client) Identity() (*auth.Identity, error) {
+	c.mu.RLock()
+	authenticator := c.Client
+	c.mu.RUnlock()
+
+	tokenGetter, ok := authenticator.(interface{ GetToken() (string, error) })
+	if !ok {
+		return nil, auth.ErrNoIdentity
+	}
+
+	token, err := tokenGetter.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.IdentityFromToken(token)
+}
+
+//Invalidate discards the cached IAM token, so the next request fetches a new one.  If
+//a new authenticator cannot be built the current one is kept.
+func (c *Client) Invalidate() {
+	if c.newAuthenticator == nil {
+		return
 	}
 
-	return nil, err
+	authenticator, err := c.newAuthenticator()
+	if err != nil {
+		return
+	}
 
+	c.mu.Lock()
+	c.Client = authenticator
+	c.mu.Unlock()
 }
@@ -0,0 +1,30 @@
+package iam
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/satcon-client-go/client/auth"
+)
+
+// DefaultCRTokenFilename is where IKS and OpenShift mount the compute resource
+// token of a pod's service account.
+const DefaultCRTokenFilename = "/var/run/secrets/tokens/vault-token"
+
+//NewTrustedProfileClient returns a Client which exchanges the compute resource token
+//found in crTokenFilename for an IAM token of the given trusted profile, so that
+//workloads running in IKS/OpenShift need not carry an API key.  Supply either
+//profileID or profileName.  An empty crTokenFilename uses DefaultCRTokenFilename, and
+//an empty url uses the production IAM endpoint.
+func NewTrustedProfileClient(crTokenFilename, profileID, profileName, url string) (*Client, error) {
+	if crTokenFilename == "" {
+		crTokenFilename = DefaultCRTokenFilename
+	}
+
+	return newClient(func() (auth.AuthClient, error) {
+		return core.NewContainerAuthenticatorBuilder().
+			SetCRTokenFilename(crTokenFilename).
+			SetIAMProfileID(profileID).
+			SetIAMProfileName(profileName).
+			SetURL(url).
+			Build()
+	})
+}
@@ -0,0 +1,133 @@
+package iam_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth/iam"
+)
+
+var _ = Describe("TrustedProfileClient", func() {
+	var (
+		tokenServer   *httptest.Server
+		tokenFilename string
+		tokenRequests []http.Request
+		tokenForms    []map[string]string
+		accessToken   string
+	)
+
+	BeforeEach(func() {
+		accessToken = "iam-access-token"
+		tokenRequests = nil
+		tokenForms = nil
+
+		tokenFilename = filepath.Join(GinkgoT().TempDir(), "cr-token")
+		Expect(os.WriteFile(tokenFilename, []byte("my-cr-token"), 0600)).To(Succeed())
+
+		// A local stand-in for the IAM token endpoint
+		tokenServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/identity/token"))
+			Expect(r.ParseForm()).To(Succeed())
+			tokenRequests = append(tokenRequests, *r)
+			tokenForms = append(tokenForms, map[string]string{
+				"grant_type":   r.PostForm.Get("grant_type"),
+				"cr_token":     r.PostForm.Get("cr_token"),
+				"profile_id":   r.PostForm.Get("profile_id"),
+				"profile_name": r.PostForm.Get("profile_name"),
+			})
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  accessToken,
+				"refresh_token": "iam-refresh-token",
+				"token_type":    "Bearer",
+				"expires_in":    3600,
+				"expiration":    time.Now().Add(time.Hour).Unix(),
+			})
+		}))
+	})
+
+	AfterEach(func() {
+		tokenServer.Close()
+	})
+
+	It("Exchanges the compute resource token for an IAM token", func() {
+		c, err := iam.NewTrustedProfileClient(tokenFilename, "profile-id", "", tokenServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, _ := http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+		Expect(c.Authenticate(req)).To(Succeed())
+		Expect(req.Header.Get("Authorization")).To(Equal("Bearer iam-access-token"))
+
+		Expect(tokenForms).To(HaveLen(1))
+		Expect(tokenForms[0]["grant_type"]).To(Equal("urn:ibm:params:oauth:grant-type:cr-token"))
+		Expect(tokenForms[0]["cr_token"]).To(Equal("my-cr-token"))
+		Expect(tokenForms[0]["profile_id"]).To(Equal("profile-id"))
+	})
+
+	It("Caches the IAM token", func() {
+		c, err := iam.NewTrustedProfileClient(tokenFilename, "", "profile-name", tokenServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		for i := 0; i < 3; i++ {
+			req, _ := http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+			Expect(c.Authenticate(req)).To(Succeed())
+		}
+		Expect(tokenRequests).To(HaveLen(1))
+		Expect(tokenForms[0]["profile_name"]).To(Equal("profile-name"))
+	})
+
+	It("Fetches a new IAM token after Invalidate", func() {
+		c, err := iam.NewTrustedProfileClient(tokenFilename, "profile-id", "", tokenServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, _ := http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+		Expect(c.Authenticate(req)).To(Succeed())
+		c.Invalidate()
+		req, _ = http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+		Expect(c.Authenticate(req)).To(Succeed())
+		Expect(tokenRequests).To(HaveLen(2))
+	})
+
+	It("Decodes the identity from the IAM token", func() {
+		var err error
+		accessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iam_id":  "iam-ServiceId-123",
+			"account": map[string]interface{}{"bss": "account-id"},
+			"exp":     time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+
+		c, err := iam.NewTrustedProfileClient(tokenFilename, "profile-id", "", tokenServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		identity, err := c.Identity()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity.OrgID).To(Equal("account-id"))
+		Expect(identity.User).To(Equal("iam-ServiceId-123"))
+	})
+
+	It("Errors when neither a profile ID nor a name is supplied", func() {
+		c, err := iam.NewTrustedProfileClient(tokenFilename, "", "", tokenServer.URL)
+		Expect(err).To(HaveOccurred())
+		Expect(c).To(BeNil())
+	})
+
+	It("Errors when the token file cannot be read", func() {
+		c, err := iam.NewTrustedProfileClient(filepath.Join(GinkgoT().TempDir(), "missing"), "profile-id", "", tokenServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, _ := http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+		Expect(c.Authenticate(req)).NotTo(Succeed())
+		Expect(tokenRequests).To(BeEmpty())
+	})
+})
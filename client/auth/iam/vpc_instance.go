@@ -0,0 +1,21 @@
+package iam
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/satcon-client-go/client/auth"
+)
+
+//NewVPCInstanceClient returns a Client which obtains an instance identity token
+//from the VPC instance metadata service and exchanges it for an IAM token of the
+//given trusted profile.  Supply either profileCRN or profileID, or neither to use
+//the profile linked to the instance.  An empty url uses the default metadata
+//service endpoint.
+func NewVPCInstanceClient(profileCRN, profileID, url string) (*Client, error) {
+	return newClient(func() (auth.AuthClient, error) {
+		return core.NewVpcInstanceAuthenticatorBuilder().
+			SetIAMProfileCRN(profileCRN).
+			SetIAMProfileID(profileID).
+			SetURL(url).
+			Build()
+	})
+}
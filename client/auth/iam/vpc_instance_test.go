@@ -0,0 +1,84 @@
+package iam_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth/iam"
+)
+
+var _ = Describe("VPCInstanceClient", func() {
+	var (
+		metadataServer *httptest.Server
+		iamTokenBodies []string
+	)
+
+	BeforeEach(func() {
+		iamTokenBodies = nil
+
+		// A local stand-in for the VPC instance metadata service
+		metadataServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			now := time.Now().UTC()
+			w.Header().Set("Content-Type", "application/json")
+
+			switch {
+			case r.Method == http.MethodPut && r.URL.Path == "/instance_identity/v1/token":
+				Expect(r.Header.Get("Metadata-Flavor")).To(Equal("ibm"))
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"access_token": "instance-identity-token",
+					"created_at":   now.Format(time.RFC3339),
+					"expires_at":   now.Add(5 * time.Minute).Format(time.RFC3339),
+					"expires_in":   300,
+				})
+			case r.Method == http.MethodPost && r.URL.Path == "/instance_identity/v1/iam_token":
+				Expect(r.Header.Get("Authorization")).To(Equal("Bearer instance-identity-token"))
+				body, _ := ioutil.ReadAll(r.Body)
+				iamTokenBodies = append(iamTokenBodies, string(body))
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"access_token": "iam-access-token",
+					"created_at":   now.Format(time.RFC3339),
+					"expires_at":   now.Add(time.Hour).Format(time.RFC3339),
+					"expires_in":   3600,
+				})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		metadataServer.Close()
+	})
+
+	It("Exchanges the instance identity token for an IAM token", func() {
+		c, err := iam.NewVPCInstanceClient("", "profile-id", metadataServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, _ := http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+		Expect(c.Authenticate(req)).To(Succeed())
+		Expect(req.Header.Get("Authorization")).To(Equal("Bearer iam-access-token"))
+		Expect(iamTokenBodies).To(ConsistOf(MatchJSON(`{"trusted_profile": {"id": "profile-id"}}`)))
+	})
+
+	It("Uses the linked profile when none is supplied", func() {
+		c, err := iam.NewVPCInstanceClient("", "", metadataServer.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, _ := http.NewRequest(http.MethodPost, "https://foo.bar", nil)
+		Expect(c.Authenticate(req)).To(Succeed())
+		Expect(iamTokenBodies).To(ConsistOf(""))
+	})
+
+	It("Errors when both a profile CRN and ID are supplied", func() {
+		c, err := iam.NewVPCInstanceClient("crn:v1:profile", "profile-id", metadataServer.URL)
+		Expect(err).To(HaveOccurred())
+		Expect(c).To(BeNil())
+	})
+})
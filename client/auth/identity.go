@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Identity describes who the credentials of an auth client belong to, as decoded
+// from its token.  The token's signature is not verified; the server remains the
+// authority on whether the token is valid.
+type Identity struct {
+	// OrgID is the Razee organization, or for IAM tokens the IBM Cloud account ID
+	OrgID string
+	// User is the user's ID, e.g. the IAM ID or the Razee user ID
+	User string
+	// Email is the user's email address, if the token carries one
+	Email string
+	// Expiry is when the token expires, or zero if it does not say
+	Expiry time.Time
+	// Claims holds all of the token's claims
+	Claims map[string]interface{}
+}
+
+// IdentityProvider is implemented by auth clients whose tokens identify the user.
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . IdentityProvider
+type IdentityProvider interface {
+	Identity() (*Identity, error)
+}
+
+// IdentityFromToken decodes the claims of a JWT.  It understands the claims used
+// by IAM tokens (account.bss, iam_id) as well as those used by Razee local tokens
+// (org_id or meta.orgs, _id).
+func IdentityFromToken(token string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		OrgID:  orgIDClaim(claims),
+		User:   firstStringClaim(claims, "iam_id", "_id", "sub", "identifier"),
+		Email:  firstStringClaim(claims, "email"),
+		Claims: claims,
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		identity.Expiry = time.Unix(int64(exp), 0)
+	}
+
+	return identity, nil
+}
+
+// ErrNoIdentity is returned by IdentityProviders which do not have a token to decode
+var ErrNoIdentity = errors.New("Auth client has no token to identify the user with")
+
+func orgIDClaim(claims jwt.MapClaims) string {
+	if account, ok := claims["account"].(map[string]interface{}); ok {
+		if bss, ok := account["bss"].(string); ok && bss != "" {
+			return bss
+		}
+	}
+
+	if orgID := firstStringClaim(claims, "org_id", "orgId"); orgID != "" {
+		return orgID
+	}
+
+	// Razee local users may belong to several orgs, the first of which is their own
+	if meta, ok := claims["meta"].(map[string]interface{}); ok {
+		if orgs, ok := meta["orgs"].([]interface{}); ok && len(orgs) > 0 {
+			if org, ok := orgs[0].(map[string]interface{}); ok {
+				if id, ok := org["_id"].(string); ok {
+					return id
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func firstStringClaim(claims jwt.MapClaims, names ...string) string {
+	for _, name := range names {
+		if value, ok := claims[name].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package auth_test
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+)
+
+var _ = Describe("IdentityFromToken", func() {
+	var expiry time.Time
+
+	sign := func(claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		return token
+	}
+
+	BeforeEach(func() {
+		expiry = time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	})
+
+	It("Decodes IAM tokens", func() {
+		identity, err := auth.IdentityFromToken(sign(jwt.MapClaims{
+			"iam_id":  "IBMid-123",
+			"sub":     "someone@ibm.com",
+			"email":   "someone@ibm.com",
+			"account": map[string]interface{}{"bss": "account-id"},
+			"exp":     expiry.Unix(),
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity.OrgID).To(Equal("account-id"))
+		Expect(identity.User).To(Equal("IBMid-123"))
+		Expect(identity.Email).To(Equal("someone@ibm.com"))
+		Expect(identity.Expiry).To(Equal(expiry))
+		Expect(identity.Claims).To(HaveKey("account"))
+	})
+
+	It("Decodes Razee local tokens", func() {
+		identity, err := auth.IdentityFromToken(sign(jwt.MapClaims{
+			"_id":        "user-id",
+			"identifier": "admin",
+			"meta": map[string]interface{}{
+				"orgs": []interface{}{map[string]interface{}{"_id": "org-id", "role": "ADMIN"}},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity.OrgID).To(Equal("org-id"))
+		Expect(identity.User).To(Equal("user-id"))
+		Expect(identity.Expiry.IsZero()).To(BeTrue())
+	})
+
+	It("Prefers an explicit org_id claim", func() {
+		identity, err := auth.IdentityFromToken(sign(jwt.MapClaims{"org_id": "org-id"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity.OrgID).To(Equal("org-id"))
+	})
+
+	It("Does not verify the signature or expiry", func() {
+		identity, err := auth.IdentityFromToken(sign(jwt.MapClaims{"org_id": "org-id", "exp": time.Now().Add(-time.Hour).Unix()}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity.OrgID).To(Equal("org-id"))
+	})
+
+	It("Errors when the token is not a JWT", func() {
+		_, err := auth.IdentityFromToken("not-a-jwt")
+		Expect(err).To(HaveOccurred())
+	})
+})
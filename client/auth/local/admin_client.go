@@ -0,0 +1,78 @@
+package local
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+// RoleAdmin is the role of a user who administers their organization
+const RoleAdmin = "ADMIN"
+
+// AdminService is the interface used to manage users and organizations of a
+// stand-alone Razee instance using local authentication.  Razee creates an
+// organization when a user signs up with an organization name it does not know yet;
+// there is no separate organization mutation.  Nor can it generate API keys:
+// Razee's local authentication only has the signUp and signIn mutations, and the
+// user API keys sent by apikey.RazeeApiKeyAuthClient are issued by the Razeedash UI.
+// Use the tokens these operations return instead, or orgkeys.OrgKeyService.AddOrgKey
+// for the org keys used by clusters.
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AdminService
+type AdminService interface {
+	SignUp(username, email, password, orgName, role string) (*types.Token, error)
+	SignIn(login, password string) (*types.Token, error)
+	CreateOrganization(orgName, username, email, password string) (*types.Token, error)
+	Organizations() ([]types.BasicOrganization, error)
+}
+
+// AdminClient is an implementation of AdminService.
+type AdminClient struct {
+	web.SatConClient
+}
+
+// NewAdminClient returns a configured instance of AdminService.  The authClient,
+// typically a LocalRazeeClient, is only needed for operations on behalf of a
+// signed in user such as Organizations.
+func NewAdminClient(endpointURL string, httpClient web.HTTPClient, authClient auth.AuthClient) (AdminService, error) {
+	if endpointURL == "" {
+		return nil, errors.New("Must supply a valid endpoint URL")
+	}
+
+	s := web.SatConClient{
+		Endpoint:   endpointURL,
+		HTTPClient: http.DefaultClient,
+		AuthClient: authClient,
+	}
+
+	if httpClient != nil {
+		s.HTTPClient = httpClient
+	}
+
+	return &AdminClient{
+		s,
+	}, nil
+}
+
+// SignUp creates a user, along with the organization if it does not exist yet, and
+// returns a token for the new user.
+func (c *AdminClient) SignUp(username, email, password, orgName, role string) (*types.Token, error) {
+	return SignUp(c.HTTPClient, c.Endpoint, username, email, password, orgName, role)
+}
+
+// SignIn returns a token for the user
+func (c *AdminClient) SignIn(login, password string) (*types.Token, error) {
+	return SignIn(c.HTTPClient, c.Endpoint, login, password)
+}
+
+// CreateOrganization creates an organization by signing up its first user as an
+// administrator, and returns a token for that user.
+func (c *AdminClient) CreateOrganization(orgName, username, email, password string) (*types.Token, error) {
+	if orgName == "" {
+		return nil, errors.New("Must supply an organization name")
+	}
+
+	return c.SignUp(username, email, password, orgName, RoleAdmin)
+}
@@ -0,0 +1,100 @@
+package local_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/auth/local"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("AdminClient", func() {
+	Describe("NewAdminClient", func() {
+		var (
+			h              *http.Client
+			endpoint       string
+			fakeAuthClient authfakes.FakeAuthClient
+		)
+
+		BeforeEach(func() {
+			endpoint = "https://razee.foo"
+		})
+
+		It("Creates a client using the default http client", func() {
+			c, err := local.NewAdminClient(endpoint, nil, &fakeAuthClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.(*local.AdminClient).HTTPClient).To(Equal(http.DefaultClient))
+		})
+
+		It("Uses the supplied client", func() {
+			h = &http.Client{Timeout: time.Second * 3}
+			c, err := local.NewAdminClient(endpoint, h, &fakeAuthClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.(*local.AdminClient).HTTPClient).To(Equal(h))
+		})
+
+		It("Errors when the endpoint URL is empty", func() {
+			c, err := local.NewAdminClient("", nil, &fakeAuthClient)
+			Expect(c).To(BeNil())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("User and organization lifecycle", func() {
+		var (
+			c local.AdminService
+			h *webfakes.FakeHTTPClient
+		)
+
+		respondWith := func(field string, value interface{}) {
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{field: value}})
+			Expect(err).NotTo(HaveOccurred())
+			h.DoReturns(&http.Response{Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes))}, nil)
+		}
+
+		requestBody := func(i int) map[string]interface{} {
+			var body map[string]interface{}
+			Expect(json.NewDecoder(h.DoArgsForCall(i).Body).Decode(&body)).To(Succeed())
+			return body
+		}
+
+		BeforeEach(func() {
+			h = &webfakes.FakeHTTPClient{}
+			c, _ = local.NewAdminClient("https://razee.foo", h, nil)
+		})
+
+		It("Creates an organization by signing up its administrator", func() {
+			respondWith(local.MutationSignUp, local.SignUpResponseDataDetails{Token: "ey123.token"})
+
+			token, err := c.CreateOrganization("myorg", "admin", "admin@foo.ibm.com", "password")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*token).To(Equal(types.Token("ey123.token")))
+
+			variables := requestBody(0)["variables"].(map[string]interface{})
+			Expect(variables["orgName"]).To(Equal("myorg"))
+			Expect(variables["role"]).To(Equal(local.RoleAdmin))
+		})
+
+		It("Errors when the organization name is empty", func() {
+			_, err := c.CreateOrganization("", "admin", "admin@foo.ibm.com", "password")
+			Expect(err).To(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(0))
+		})
+
+		It("Signs in", func() {
+			respondWith(local.MutationSignIn, local.SignInResponseDataDetails{Token: "ey123.token"})
+
+			token, err := c.SignIn("admin", "password")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*token).To(Equal(types.Token("ey123.token")))
+		})
+	})
+})
@@ -1,10 +1,13 @@
 package local
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/IBM/satcon-client-go/client/auth"
 	"github.com/IBM/satcon-client-go/client/types"
 	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/golang-jwt/jwt/v4"
@@ -14,12 +17,25 @@ const TokenValidityDuration = 40 * time.Minute
 const MinimumTimeTokenStillValid = 5 * time.Minute
 const AuthorizationHeaderKey = "Authorization"
 
+// BackgroundRefreshLeadTime is how long before the cached token would be considered
+// invalid that the background refresh signs in again.
+const BackgroundRefreshLeadTime = 1 * time.Minute
+
+// BackgroundRefreshRetryInterval is how long the background refresh waits before
+// trying again after a failed sign in.
+const BackgroundRefreshRetryInterval = 30 * time.Second
+
 type LocalRazeeClient struct {
 	HTTPClient web.HTTPClient
 	url        string
 	login      string
 	password   string
 
+	// mu guards the cached token fields below
+	mu sync.RWMutex
+	// refreshMu ensures only one sign in is in flight at a time
+	refreshMu sync.Mutex
+
 	// cached jwt token from previous request
 	token types.Token
 	// timestamp of the time when the previous
@@ -30,34 +46,149 @@ type LocalRazeeClient struct {
 	expireTimestamp time.Time
 }
 
+// Authenticate adds the cached bearer token to the request, signing in first if
+// there is no valid token.  It is safe to call from multiple goroutines; concurrent
+// callers needing a new token share a single sign in.
 func (l *LocalRazeeClient) Authenticate(request *http.Request) error {
-	invalidExpiredTimestamp := time.Until(l.expireTimestamp) < MinimumTimeTokenStillValid
-	invalidTokenTimestamp := time.Since(l.tokenTimestamp) >= TokenValidityDuration
-	if l.token == "" || invalidExpiredTimestamp || invalidTokenTimestamp {
-		token, err := SignIn(l.HTTPClient, l.url, l.login, l.password)
-		if err != nil {
-			return err
+	token, err := l.currentToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Add(AuthorizationHeaderKey, "Bearer "+string(token))
+	return nil
+}
+
+// StartBackgroundRefresh signs in again shortly before the cached token would be
+// considered invalid, so that requests never have to wait for a sign in.  It runs
+// until ctx is cancelled.  Failed sign ins are retried after BackgroundRefreshRetryInterval;
+// Authenticate will still sign in on demand in the meantime.  Tokens which expire
+// too soon to be refreshed ahead of time are also refreshed no more often than
+// BackgroundRefreshRetryInterval.
+func (l *LocalRazeeClient) StartBackgroundRefresh(ctx context.Context) {
+	go func() {
+		delay := l.untilBackgroundRefresh()
+		for {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if _, err := l.refresh(true); err != nil {
+				delay = BackgroundRefreshRetryInterval
+				continue
+			}
+
+			// A token expiring within MinimumTimeTokenStillValid+BackgroundRefreshLeadTime
+			// is already due for refresh, which would otherwise sign in again at once
+			if delay = l.untilBackgroundRefresh(); delay <= 0 {
+				delay = BackgroundRefreshRetryInterval
+			}
 		}
-		if token == nil {
-			return fmt.Errorf("Could not get a token by signing in %v to %v", l.login, l.url)
+	}()
+}
+
+// Identity decodes the claims of the cached token, signing in first if there is no
+// valid token.
+func (l *LocalRazeeClient) Identity() (*auth.Identity, error) {
+	token, err := l.currentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.IdentityFromToken(string(token))
+}
+
+// Invalidate discards the cached token, so the next call to Authenticate signs in again.
+func (l *LocalRazeeClient) Invalidate() {
+	l.mu.Lock()
+	l.token = ""
+	l.mu.Unlock()
+}
+
+func (l *LocalRazeeClient) currentToken() (types.Token, error) {
+	l.mu.RLock()
+	token, valid := l.token, l.tokenValid()
+	l.mu.RUnlock()
+
+	if valid {
+		return token, nil
+	}
+
+	return l.refresh(false)
+}
+
+// tokenValid reports whether the cached token can still be used.  The caller must hold mu.
+func (l *LocalRazeeClient) tokenValid() bool {
+	return l.token != "" && time.Now().Before(l.validUntil())
+}
+
+// validUntil returns when the cached token stops being usable.  The caller must hold mu.
+func (l *LocalRazeeClient) validUntil() time.Time {
+	// Token timestamp is a backup mechanism if the token does not contain the 'exp' field
+	// or the value of that field could not be parsed
+	validUntil := l.tokenTimestamp.Add(TokenValidityDuration)
+	if !l.expireTimestamp.IsZero() {
+		if expiry := l.expireTimestamp.Add(-MinimumTimeTokenStillValid); expiry.Before(validUntil) {
+			validUntil = expiry
 		}
-		l.token = *token
-		parsedToken, err := jwt.Parse(string(*token), nil)
-		if parsedToken != nil {
-			claims, _ := parsedToken.Claims.(jwt.MapClaims)
-			if expiredTimestamp, ok := claims["exp"]; ok {
-				if d, ok := expiredTimestamp.(float64); ok {
-					l.expireTimestamp = time.Unix(int64(d), 0)
-				}
-			}
+	}
+	return validUntil
+}
+
+func (l *LocalRazeeClient) untilBackgroundRefresh() time.Duration {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.token == "" {
+		return 0
+	}
+	return time.Until(l.validUntil().Add(-BackgroundRefreshLeadTime))
+}
+
+// refresh signs in and caches the new token.  Unless force is set, a caller that
+// was waiting on another goroutine's sign in reuses the token it fetched.
+func (l *LocalRazeeClient) refresh(force bool) (types.Token, error) {
+	l.refreshMu.Lock()
+	defer l.refreshMu.Unlock()
+
+	if !force {
+		l.mu.RLock()
+		token, valid := l.token, l.tokenValid()
+		l.mu.RUnlock()
+		if valid {
+			return token, nil
 		}
+	}
 
-		// Token timestamp is a backup mechanism if the token does not contain the 'exp' field
-		// or the value of that field could not be parsed
-		l.tokenTimestamp = time.Now()
+	token, err := SignIn(l.HTTPClient, l.url, l.login, l.password)
+	if err != nil {
+		return "", err
 	}
-	request.Header.Add(AuthorizationHeaderKey, "Bearer "+string(l.token))
-	return nil
+	if token == nil {
+		return "", fmt.Errorf("Could not get a token by signing in %v to %v", l.login, l.url)
+	}
+
+	var expireTimestamp time.Time
+	parsedToken, _ := jwt.Parse(string(*token), nil)
+	if parsedToken != nil {
+		claims, _ := parsedToken.Claims.(jwt.MapClaims)
+		if expiredTimestamp, ok := claims["exp"]; ok {
+			if d, ok := expiredTimestamp.(float64); ok {
+				expireTimestamp = time.Unix(int64(d), 0)
+			}
+		}
+	}
+
+	l.mu.Lock()
+	l.token = *token
+	l.expireTimestamp = expireTimestamp
+	l.tokenTimestamp = time.Now()
+	l.mu.Unlock()
+
+	return *token, nil
 }
 
 func NewClient(url string, login string, password string) (*LocalRazeeClient, error) {
@@ -2,9 +2,12 @@ package local_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/IBM/satcon-client-go/client/auth/local"
@@ -46,7 +49,7 @@ var _ = Describe("Client", func() {
 		var token string
 		var h *webfakes.FakeHTTPClient
 		var response *http.Response
-		var signInResponse local.SignInResponse
+		var signInResponse *local.SignInResponseDataDetails
 
 		BeforeEach(func() {
 			var err error
@@ -63,15 +66,11 @@ var _ = Describe("Client", func() {
 				Header: http.Header{},
 			}
 
-			signInResponse = local.SignInResponse{
-				Data: &local.SignInResponseData{
-					Details: &local.SignInResponseDataDetails{
-						Token: types.Token(token),
-					},
-				},
+			signInResponse = &local.SignInResponseDataDetails{
+				Token: types.Token(token),
 			}
 
-			respBodyBytes, err := json.Marshal(signInResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{local.MutationSignIn: signInResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -101,5 +100,141 @@ var _ = Describe("Client", func() {
 			// Check that there was only one invocation (the second authenticate should come from the cache)
 			Expect(len(h.Invocations())).To(Equal(1))
 		})
+
+		It("Decodes the identity from the token", func() {
+			localClient, err := local.NewClientWithHttpClient(h, "http://foo.bar", "user", "password")
+			Expect(err).NotTo(HaveOccurred())
+			identity, err := localClient.Identity()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identity.Expiry).NotTo(BeZero())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Signs in again after Invalidate", func() {
+			localClient, err := local.NewClientWithHttpClient(h, "http://foo.bar", "user", "password")
+			Expect(err).NotTo(HaveOccurred())
+			request := http.Request{Header: http.Header{}}
+			Expect(localClient.Authenticate(&request)).To(Succeed())
+
+			h.DoReturns(signInResponseFor(signedToken(time.Now().Add(4*time.Hour), 1)), nil)
+			localClient.Invalidate()
+
+			refreshed := http.Request{Header: http.Header{}}
+			Expect(localClient.Authenticate(&refreshed)).To(Succeed())
+			Expect(h.DoCallCount()).To(Equal(2))
+			Expect(refreshed.Header.Get(local.AuthorizationHeaderKey)).NotTo(Equal(request.Header.Get(local.AuthorizationHeaderKey)))
+		})
+
+		Describe("Concurrent use", func() {
+			var signInCount int32
+
+			BeforeEach(func() {
+				atomic.StoreInt32(&signInCount, 0)
+				h.DoStub = func(*http.Request) (*http.Response, error) {
+					n := atomic.AddInt32(&signInCount, 1)
+					// Give other goroutines the chance to pile up behind this sign in
+					time.Sleep(10 * time.Millisecond)
+					return signInResponseFor(signedToken(time.Now().Add(4*time.Hour), n)), nil
+				}
+			})
+
+			It("Signs in only once for concurrent callers", func() {
+				localClient, err := local.NewClientWithHttpClient(h, "http://foo.bar", "user", "password")
+				Expect(err).NotTo(HaveOccurred())
+
+				var wg sync.WaitGroup
+				headers := make([]string, 20)
+				for i := range headers {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+						request := http.Request{Header: http.Header{}}
+						Expect(localClient.Authenticate(&request)).To(Succeed())
+						headers[i] = request.Header.Get(local.AuthorizationHeaderKey)
+					}(i)
+				}
+				wg.Wait()
+
+				Expect(atomic.LoadInt32(&signInCount)).To(Equal(int32(1)))
+				for _, header := range headers {
+					Expect(header).To(Equal(headers[0]))
+				}
+			})
+
+			It("Refreshes the token in the background before it becomes invalid", func() {
+				// The first token becomes due for a background refresh after about a second
+				expiry := time.Now().Add(local.MinimumTimeTokenStillValid + local.BackgroundRefreshLeadTime + time.Second)
+				h.DoReturns(signInResponseFor(signedToken(expiry, 0)), nil)
+
+				localClient, err := local.NewClientWithHttpClient(h, "http://foo.bar", "user", "password")
+				Expect(err).NotTo(HaveOccurred())
+				request := http.Request{Header: http.Header{}}
+				Expect(localClient.Authenticate(&request)).To(Succeed())
+				Expect(h.DoCallCount()).To(Equal(1))
+
+				h.DoReturns(signInResponseFor(signedToken(time.Now().Add(4*time.Hour), 1)), nil)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				localClient.StartBackgroundRefresh(ctx)
+
+				Eventually(h.DoCallCount, 5*time.Second).Should(Equal(2))
+
+				// Requests use the refreshed token without signing in again
+				refreshed := http.Request{Header: http.Header{}}
+				Expect(localClient.Authenticate(&refreshed)).To(Succeed())
+				Expect(refreshed.Header.Get(local.AuthorizationHeaderKey)).NotTo(Equal(request.Header.Get(local.AuthorizationHeaderKey)))
+				Consistently(h.DoCallCount, 200*time.Millisecond).Should(Equal(2))
+			})
+
+			It("Does not sign in repeatedly when the token expires too soon to refresh ahead of time", func() {
+				// Expires within MinimumTimeTokenStillValid+BackgroundRefreshLeadTime, so it is
+				// due for refresh as soon as it is issued
+				h.DoStub = func(*http.Request) (*http.Response, error) {
+					return signInResponseFor(signedToken(time.Now().Add(2*time.Minute), 0)), nil
+				}
+
+				localClient, err := local.NewClientWithHttpClient(h, "http://foo.bar", "user", "password")
+				Expect(err).NotTo(HaveOccurred())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				localClient.StartBackgroundRefresh(ctx)
+
+				Eventually(h.DoCallCount).Should(Equal(1))
+				Consistently(h.DoCallCount, 300*time.Millisecond).Should(BeNumerically("<=", 2))
+			})
+
+			It("Stops refreshing once the context is cancelled", func() {
+				h.DoReturns(signInResponseFor(signedToken(time.Now().Add(4*time.Hour), 0)), nil)
+
+				localClient, err := local.NewClientWithHttpClient(h, "http://foo.bar", "user", "password")
+				Expect(err).NotTo(HaveOccurred())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				localClient.StartBackgroundRefresh(ctx)
+				// Without a token, the first refresh happens straight away
+				Eventually(h.DoCallCount).Should(Equal(1))
+				cancel()
+				Consistently(h.DoCallCount, 200*time.Millisecond).Should(Equal(1))
+			})
+		})
 	})
 })
+
+func signedToken(expiry time.Time, serial int32) string {
+	tokenWithClaim := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp":    expiry.Unix(),
+		"serial": serial,
+	})
+	token, err := tokenWithClaim.SignedString([]byte("secret"))
+	Expect(err).NotTo(HaveOccurred())
+	return token
+}
+
+func signInResponseFor(token string) *http.Response {
+	respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{local.MutationSignIn: local.SignInResponseDataDetails{Token: types.Token(token)}}})
+	Expect(err).NotTo(HaveOccurred())
+	return &http.Response{Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes))}
+}
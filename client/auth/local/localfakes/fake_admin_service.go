@@ -0,0 +1,361 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package localfakes
+
+import (
+	"sync"
+
+	"github.com/IBM/satcon-client-go/client/auth/local"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+type FakeAdminService struct {
+	CreateOrganizationStub        func(string, string, string, string) (*types.Token, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	createOrganizationReturns struct {
+		result1 *types.Token
+		result2 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 *types.Token
+		result2 error
+	}
+	OrganizationsStub        func() ([]types.BasicOrganization, error)
+	organizationsMutex       sync.RWMutex
+	organizationsArgsForCall []struct {
+	}
+	organizationsReturns struct {
+		result1 []types.BasicOrganization
+		result2 error
+	}
+	organizationsReturnsOnCall map[int]struct {
+		result1 []types.BasicOrganization
+		result2 error
+	}
+	SignInStub        func(string, string) (*types.Token, error)
+	signInMutex       sync.RWMutex
+	signInArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	signInReturns struct {
+		result1 *types.Token
+		result2 error
+	}
+	signInReturnsOnCall map[int]struct {
+		result1 *types.Token
+		result2 error
+	}
+	SignUpStub        func(string, string, string, string, string) (*types.Token, error)
+	signUpMutex       sync.RWMutex
+	signUpArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	signUpReturns struct {
+		result1 *types.Token
+		result2 error
+	}
+	signUpReturnsOnCall map[int]struct {
+		result1 *types.Token
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAdminService) CreateOrganization(arg1 string, arg2 string, arg3 string, arg4 string) (*types.Token, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateOrganizationStub
+	fakeReturns := fake.createOrganizationReturns
+	fake.recordInvocation("CreateOrganization", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createOrganizationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdminService) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeAdminService) CreateOrganizationCalls(stub func(string, string, string, string) (*types.Token, error)) {
+	fake.createOrganizationMutex.Lock()
+	defer fake.createOrganizationMutex.Unlock()
+	fake.CreateOrganizationStub = stub
+}
+
+func (fake *FakeAdminService) CreateOrganizationArgsForCall(i int) (string, string, string, string) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	argsForCall := fake.createOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAdminService) CreateOrganizationReturns(result1 *types.Token, result2 error) {
+	fake.createOrganizationMutex.Lock()
+	defer fake.createOrganizationMutex.Unlock()
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 *types.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) CreateOrganizationReturnsOnCall(i int, result1 *types.Token, result2 error) {
+	fake.createOrganizationMutex.Lock()
+	defer fake.createOrganizationMutex.Unlock()
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 *types.Token
+			result2 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 *types.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) Organizations() ([]types.BasicOrganization, error) {
+	fake.organizationsMutex.Lock()
+	ret, specificReturn := fake.organizationsReturnsOnCall[len(fake.organizationsArgsForCall)]
+	fake.organizationsArgsForCall = append(fake.organizationsArgsForCall, struct {
+	}{})
+	stub := fake.OrganizationsStub
+	fakeReturns := fake.organizationsReturns
+	fake.recordInvocation("Organizations", []interface{}{})
+	fake.organizationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdminService) OrganizationsCallCount() int {
+	fake.organizationsMutex.RLock()
+	defer fake.organizationsMutex.RUnlock()
+	return len(fake.organizationsArgsForCall)
+}
+
+func (fake *FakeAdminService) OrganizationsCalls(stub func() ([]types.BasicOrganization, error)) {
+	fake.organizationsMutex.Lock()
+	defer fake.organizationsMutex.Unlock()
+	fake.OrganizationsStub = stub
+}
+
+func (fake *FakeAdminService) OrganizationsReturns(result1 []types.BasicOrganization, result2 error) {
+	fake.organizationsMutex.Lock()
+	defer fake.organizationsMutex.Unlock()
+	fake.OrganizationsStub = nil
+	fake.organizationsReturns = struct {
+		result1 []types.BasicOrganization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) OrganizationsReturnsOnCall(i int, result1 []types.BasicOrganization, result2 error) {
+	fake.organizationsMutex.Lock()
+	defer fake.organizationsMutex.Unlock()
+	fake.OrganizationsStub = nil
+	if fake.organizationsReturnsOnCall == nil {
+		fake.organizationsReturnsOnCall = make(map[int]struct {
+			result1 []types.BasicOrganization
+			result2 error
+		})
+	}
+	fake.organizationsReturnsOnCall[i] = struct {
+		result1 []types.BasicOrganization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) SignIn(arg1 string, arg2 string) (*types.Token, error) {
+	fake.signInMutex.Lock()
+	ret, specificReturn := fake.signInReturnsOnCall[len(fake.signInArgsForCall)]
+	fake.signInArgsForCall = append(fake.signInArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SignInStub
+	fakeReturns := fake.signInReturns
+	fake.recordInvocation("SignIn", []interface{}{arg1, arg2})
+	fake.signInMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdminService) SignInCallCount() int {
+	fake.signInMutex.RLock()
+	defer fake.signInMutex.RUnlock()
+	return len(fake.signInArgsForCall)
+}
+
+func (fake *FakeAdminService) SignInCalls(stub func(string, string) (*types.Token, error)) {
+	fake.signInMutex.Lock()
+	defer fake.signInMutex.Unlock()
+	fake.SignInStub = stub
+}
+
+func (fake *FakeAdminService) SignInArgsForCall(i int) (string, string) {
+	fake.signInMutex.RLock()
+	defer fake.signInMutex.RUnlock()
+	argsForCall := fake.signInArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAdminService) SignInReturns(result1 *types.Token, result2 error) {
+	fake.signInMutex.Lock()
+	defer fake.signInMutex.Unlock()
+	fake.SignInStub = nil
+	fake.signInReturns = struct {
+		result1 *types.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) SignInReturnsOnCall(i int, result1 *types.Token, result2 error) {
+	fake.signInMutex.Lock()
+	defer fake.signInMutex.Unlock()
+	fake.SignInStub = nil
+	if fake.signInReturnsOnCall == nil {
+		fake.signInReturnsOnCall = make(map[int]struct {
+			result1 *types.Token
+			result2 error
+		})
+	}
+	fake.signInReturnsOnCall[i] = struct {
+		result1 *types.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) SignUp(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string) (*types.Token, error) {
+	fake.signUpMutex.Lock()
+	ret, specificReturn := fake.signUpReturnsOnCall[len(fake.signUpArgsForCall)]
+	fake.signUpArgsForCall = append(fake.signUpArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SignUpStub
+	fakeReturns := fake.signUpReturns
+	fake.recordInvocation("SignUp", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.signUpMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdminService) SignUpCallCount() int {
+	fake.signUpMutex.RLock()
+	defer fake.signUpMutex.RUnlock()
+	return len(fake.signUpArgsForCall)
+}
+
+func (fake *FakeAdminService) SignUpCalls(stub func(string, string, string, string, string) (*types.Token, error)) {
+	fake.signUpMutex.Lock()
+	defer fake.signUpMutex.Unlock()
+	fake.SignUpStub = stub
+}
+
+func (fake *FakeAdminService) SignUpArgsForCall(i int) (string, string, string, string, string) {
+	fake.signUpMutex.RLock()
+	defer fake.signUpMutex.RUnlock()
+	argsForCall := fake.signUpArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAdminService) SignUpReturns(result1 *types.Token, result2 error) {
+	fake.signUpMutex.Lock()
+	defer fake.signUpMutex.Unlock()
+	fake.SignUpStub = nil
+	fake.signUpReturns = struct {
+		result1 *types.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) SignUpReturnsOnCall(i int, result1 *types.Token, result2 error) {
+	fake.signUpMutex.Lock()
+	defer fake.signUpMutex.Unlock()
+	fake.SignUpStub = nil
+	if fake.signUpReturnsOnCall == nil {
+		fake.signUpReturnsOnCall = make(map[int]struct {
+			result1 *types.Token
+			result2 error
+		})
+	}
+	fake.signUpReturnsOnCall[i] = struct {
+		result1 *types.Token
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.organizationsMutex.RLock()
+	defer fake.organizationsMutex.RUnlock()
+	fake.signInMutex.RLock()
+	defer fake.signInMutex.RUnlock()
+	fake.signUpMutex.RLock()
+	defer fake.signUpMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAdminService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ local.AdminService = new(FakeAdminService)
@@ -0,0 +1,39 @@
+package local
+
+import (
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+const (
+	QueryOrganizations       = "organizations"
+	OrganizationsVarTemplate = ``
+)
+
+// OrganizationsVariables are the variables used for the organizations query
+type OrganizationsVariables struct {
+	actions.GraphQLQuery
+}
+
+// NewOrganizationsVariables returns required query variables
+func NewOrganizationsVariables() OrganizationsVariables {
+	vars := OrganizationsVariables{}
+
+	vars.Type = actions.QueryTypeQuery
+	vars.QueryName = QueryOrganizations
+	vars.Args = map[string]string{}
+	vars.Returns = []string{
+		"id",
+		"name",
+	}
+
+	return vars
+}
+
+// Organizations returns the organizations the signed in user belongs to
+func (c *AdminClient) Organizations() ([]types.BasicOrganization, error) {
+	vars := NewOrganizationsVariables()
+
+	return web.Do[[]types.BasicOrganization](&c.SatConClient, QueryOrganizations, OrganizationsVarTemplate, vars, nil)
+}
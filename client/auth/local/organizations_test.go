@@ -0,0 +1,100 @@
+package local_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/auth/local"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Organizations", func() {
+
+	var (
+		fakeAuthClient authfakes.FakeAuthClient
+	)
+
+	Describe("NewOrganizationsVariables", func() {
+		It("Returns a correctly populated instance of OrganizationsVariables", func() {
+			vars := local.NewOrganizationsVariables()
+			Expect(vars.Type).To(Equal(actions.QueryTypeQuery))
+			Expect(vars.QueryName).To(Equal(local.QueryOrganizations))
+			Expect(vars.Returns).To(ConsistOf(
+				"id",
+				"name",
+			))
+		})
+	})
+
+	Describe("Organizations", func() {
+		var (
+			c                     local.AdminService
+			h                     *webfakes.FakeHTTPClient
+			response              *http.Response
+			organizationsResponse []types.BasicOrganization
+		)
+
+		BeforeEach(func() {
+			organizationsResponse = []types.BasicOrganization{
+				{ID: "org1-id", Name: "org1"},
+				{ID: "org2-id", Name: "org2"},
+			}
+
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{local.QueryOrganizations: organizationsResponse}})
+			Expect(err).NotTo(HaveOccurred())
+			response = &http.Response{
+				Body: ioutil.NopCloser(bytes.NewReader(respBodyBytes)),
+			}
+
+			h = &webfakes.FakeHTTPClient{}
+			h.DoReturns(response, nil)
+
+			c, _ = local.NewAdminClient("https://razee.foo", h, &fakeAuthClient)
+		})
+
+		It("Makes a valid http request", func() {
+			_, err := c.Organizations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h.DoCallCount()).To(Equal(1))
+		})
+
+		It("Returns the organizations of the signed in user", func() {
+			organizations, _ := c.Organizations()
+			Expect(organizations).To(Equal(organizationsResponse))
+		})
+
+		Context("When query execution errors", func() {
+			BeforeEach(func() {
+				h.DoReturns(response, errors.New("Kablooie!"))
+			})
+
+			It("Bubbles up the error", func() {
+				_, err := c.Organizations()
+				Expect(err).To(MatchError("Kablooie!"))
+			})
+		})
+
+		Context("When the response is empty for some reason", func() {
+			BeforeEach(func() {
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
+				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
+			})
+
+			It("Returns an ErrEmptyResponse", func() {
+				organizations, err := c.Organizations()
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
+				Expect(organizations).To(BeNil())
+			})
+		})
+	})
+})
@@ -40,34 +40,17 @@ func NewSignInVariables(login string, password string) AddSignInVariables {
 	return vars
 }
 
-// SignInResponse is the response body we get upon a successful user
-// creation.
-type SignInResponse struct {
-	Data *SignInResponseData `json:"data,omitempty"`
-}
-
-type SignInResponseData struct {
-	Details *SignInResponseDataDetails `json:"signIn,omitempty"`
-}
-
 type SignInResponseDataDetails struct {
 	Token types.Token `json:"token,omitempty"`
 }
 
 func SignIn(client web.HTTPClient, endpoint string, login string, password string) (*types.Token, error) {
-	var response SignInResponse
-
 	vars := NewSignInVariables(login, password)
 
-	err := web.DoQuery(client, endpoint, nil, SignInVarTemplate, vars, nil, &response)
-
+	details, err := web.Do[*SignInResponseDataDetails](&web.SatConClient{Endpoint: endpoint, HTTPClient: client}, MutationSignIn, SignInVarTemplate, vars, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.Data != nil {
-		return &response.Data.Details.Token, err
-	}
-
-	return nil, err
+	return &details.Token, nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/satcon-client-go/client/actions"
 	"github.com/IBM/satcon-client-go/client/auth/local"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -55,19 +56,15 @@ var _ = Describe("Signing in a User", func() {
 
 	Describe("SignIn", func() {
 		var (
-			signInResponse local.SignInResponse
+			signInResponse *local.SignInResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			signInResponse = local.SignInResponse{
-				Data: &local.SignInResponseData{
-					Details: &local.SignInResponseDataDetails{
-						Token: "ey123.mytoken",
-					},
-				},
+			signInResponse = &local.SignInResponseDataDetails{
+				Token: "ey123.mytoken",
 			}
 
-			respBodyBytes, err := json.Marshal(signInResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{local.MutationSignIn: signInResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -83,7 +80,7 @@ var _ = Describe("Signing in a User", func() {
 			details, _ := local.SignIn(h, endpoint, login, password)
 			Expect(details).NotTo(BeNil())
 
-			expected := signInResponse.Data.Details.Token
+			expected := signInResponse.Token
 			Expect(*details).To(Equal(expected))
 		})
 
@@ -100,13 +97,13 @@ var _ = Describe("Signing in a User", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(local.SignInResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := local.SignIn(h, endpoint, login, password)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
@@ -49,34 +49,17 @@ func NewSignUpVariables(username string, email string, password string, orgName
 	return vars
 }
 
-// SignUpResponse is the response body we get upon a successful user
-// creation.
-type SignUpResponse struct {
-	Data *SignUpResponseData `json:"data,omitempty"`
-}
-
-type SignUpResponseData struct {
-	Details *SignUpResponseDataDetails `json:"signUp,omitempty"`
-}
-
 type SignUpResponseDataDetails struct {
 	Token types.Token `json:"token,omitempty"`
 }
 
 func SignUp(client web.HTTPClient, endpoint string, username string, email string, password string, orgName string, role string) (*types.Token, error) {
-	var response SignUpResponse
-
 	vars := NewSignUpVariables(username, email, password, orgName, role)
 
-	err := web.DoQuery(client, endpoint, nil, SignUpVarTemplate, vars, nil, &response)
-
+	details, err := web.Do[*SignUpResponseDataDetails](&web.SatConClient{Endpoint: endpoint, HTTPClient: client}, MutationSignUp, SignUpVarTemplate, vars, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.Data != nil {
-		return &response.Data.Details.Token, err
-	}
-
-	return nil, err
+	return &details.Token, nil
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/IBM/satcon-client-go/client/actions/users"
 	"github.com/IBM/satcon-client-go/client/auth/authfakes"
 	"github.com/IBM/satcon-client-go/client/auth/local"
+	"github.com/IBM/satcon-client-go/client/web"
 	"github.com/IBM/satcon-client-go/client/web/webfakes"
 )
 
@@ -71,19 +72,15 @@ var _ = Describe("Adding a User", func() {
 
 	Describe("SignUp", func() {
 		var (
-			signUpResponse local.SignUpResponse
+			signUpResponse *local.SignUpResponseDataDetails
 		)
 
 		BeforeEach(func() {
-			signUpResponse = local.SignUpResponse{
-				Data: &local.SignUpResponseData{
-					Details: &local.SignUpResponseDataDetails{
-						Token: "ey123.mytoken",
-					},
-				},
+			signUpResponse = &local.SignUpResponseDataDetails{
+				Token: "ey123.mytoken",
 			}
 
-			respBodyBytes, err := json.Marshal(signUpResponse)
+			respBodyBytes, err := json.Marshal(map[string]interface{}{"data": map[string]interface{}{local.MutationSignUp: signUpResponse}})
 			Expect(err).NotTo(HaveOccurred())
 
 			response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
@@ -99,7 +96,7 @@ var _ = Describe("Adding a User", func() {
 			details, _ := local.SignUp(h, endpoint, username, email, password, orgName, role)
 			Expect(details).NotTo(BeNil())
 
-			expected := signUpResponse.Data.Details.Token
+			expected := signUpResponse.Token
 			Expect(*details).To(Equal(expected))
 		})
 
@@ -116,13 +113,13 @@ var _ = Describe("Adding a User", func() {
 
 		Context("When the response is empty for some reason", func() {
 			BeforeEach(func() {
-				respBodyBytes, _ := json.Marshal(local.SignUpResponse{})
+				respBodyBytes, _ := json.Marshal(map[string]interface{}{})
 				response.Body = ioutil.NopCloser(bytes.NewReader(respBodyBytes))
 			})
 
-			It("Returns nil", func() {
+			It("Returns an ErrEmptyResponse", func() {
 				details, err := local.SignUp(h, endpoint, username, email, password, orgName, role)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(BeAssignableToTypeOf(&web.ErrEmptyResponse{}))
 				Expect(details).To(BeNil())
 			})
 		})
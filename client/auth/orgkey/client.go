@@ -0,0 +1,31 @@
+package orgkey
+
+import (
+	"errors"
+	"net/http"
+)
+
+// OrgKeyHeader is the header Razee agents use to authenticate with their org key
+const OrgKeyHeader = "razee-org-key"
+
+// RazeeOrgKeyAuthClient authenticates requests with an organization's org key, the
+// same way the Razee agents running on a cluster do.  It is needed for agent-facing
+// operations such as those in the agent package.
+type RazeeOrgKeyAuthClient struct {
+	orgKey string
+}
+
+// Authenticate adds the org key to the request
+func (r *RazeeOrgKeyAuthClient) Authenticate(request *http.Request) error {
+	request.Header.Set(OrgKeyHeader, r.orgKey)
+	return nil
+}
+
+// NewClient returns a RazeeOrgKeyAuthClient for the given org key
+func NewClient(orgKey string) (*RazeeOrgKeyAuthClient, error) {
+	if orgKey == "" {
+		return nil, errors.New("Must supply an org key")
+	}
+
+	return &RazeeOrgKeyAuthClient{orgKey: orgKey}, nil
+}
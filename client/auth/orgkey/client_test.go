@@ -0,0 +1,27 @@
+package orgkey_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth/orgkey"
+)
+
+var _ = Describe("Client", func() {
+	It("Adds the org key to the request", func() {
+		client, err := orgkey.NewClient("some-org-key")
+		Expect(err).NotTo(HaveOccurred())
+
+		request := http.Request{Header: http.Header{}}
+		Expect(client.Authenticate(&request)).To(Succeed())
+		Expect(request.Header.Get(orgkey.OrgKeyHeader)).To(Equal("some-org-key"))
+	})
+
+	It("Errors when the org key is empty", func() {
+		client, err := orgkey.NewClient("")
+		Expect(err).To(MatchError("Must supply an org key"))
+		Expect(client).To(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package orgkey_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrgKey(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Org Key Auth Suite")
+}
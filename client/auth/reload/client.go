@@ -0,0 +1,203 @@
+// Package reload provides an auth client which rebuilds its underlying auth client
+// whenever a credentials file changes, e.g. a Kubernetes secret which gets rotated.
+package reload
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/apikey"
+	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/auth/local"
+)
+
+// Builder builds an auth client from the contents of the credentials file
+type Builder func(contents []byte) (auth.AuthClient, error)
+
+// IAMBuilder returns a Builder for an iam.Client using the API key held in the file
+func IAMBuilder(url string) Builder {
+	return func(contents []byte) (auth.AuthClient, error) {
+		return iam.NewIAMClient(secret(contents), url)
+	}
+}
+
+// APIKeyBuilder returns a Builder for an apikey.RazeeApiKeyAuthClient using the API key
+// held in the file
+func APIKeyBuilder() Builder {
+	return func(contents []byte) (auth.AuthClient, error) {
+		return apikey.NewClient(secret(contents))
+	}
+}
+
+// LocalBuilder returns a Builder for a local.LocalRazeeClient which signs in as login
+// with the password held in the file
+func LocalBuilder(url, login string) Builder {
+	return func(contents []byte) (auth.AuthClient, error) {
+		return local.NewClient(url, login, secret(contents))
+	}
+}
+
+// secret strips the trailing newline editors and `echo` tend to leave in files
+func secret(contents []byte) string {
+	return strings.TrimSpace(string(contents))
+}
+
+// Client is an auth client which delegates to the auth client built from the current
+// contents of a credentials file.  When the file changes the auth client is rebuilt;
+// requests which are already being authenticated finish with the old one.  If the
+// new contents cannot be built into an auth client the old one is kept, and the error
+// is available from Err.
+type Client struct {
+	filename string
+	build    Builder
+	watcher  *fsnotify.Watcher
+
+	// reloadMu serializes reloads, so an older file can never replace a newer one
+	reloadMu sync.Mutex
+
+	mu       sync.RWMutex
+	client   auth.AuthClient
+	contents []byte
+	err      error
+
+	done chan struct{}
+}
+
+// NewClient builds the auth client from the credentials file and starts watching the
+// file for changes.  Call Close to stop watching.
+func NewClient(filename string, build Builder) (*Client, error) {
+	if filename == "" {
+		return nil, errors.New("Must supply a credentials file")
+	}
+	if build == nil {
+		return nil, errors.New("Must supply a builder")
+	}
+
+	c := &Client{
+		filename: filename,
+		build:    build,
+		done:     make(chan struct{}),
+	}
+
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// Watch the directory rather than the file itself, since Kubernetes updates
+	// mounted secrets by swapping a symlink rather than writing to the file.
+	if err = watcher.Add(filepath.Dir(filename)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	c.watcher = watcher
+
+	go c.watch()
+
+	return c, nil
+}
+
+// Authenticate authenticates the request with the current auth client
+func (c *Client) Authenticate(request *http.Request) error {
+	return c.current().Authenticate(request)
+}
+
+// Invalidate invalidates the current auth client if it is an auth.RefreshableAuthClient
+func (c *Client) Invalidate() {
+	if refreshable, ok := c.current().(auth.RefreshableAuthClient); ok {
+		refreshable.Invalidate()
+	}
+}
+
+// Identity returns the identity of the current auth client, if it is an auth.IdentityProvider
+func (c *Client) Identity() (*auth.Identity, error) {
+	if provider, ok := c.current().(auth.IdentityProvider); ok {
+		return provider.Identity()
+	}
+	return nil, auth.ErrNoIdentity
+}
+
+// Reload rebuilds the auth client if the contents of the credentials file have
+// changed.  It is called automatically when the file changes.
+func (c *Client) Reload() error {
+	c.reloadMu.Lock()
+	defer c.reloadMu.Unlock()
+
+	contents, err := os.ReadFile(c.filename)
+	if err == nil {
+		c.mu.RLock()
+		unchanged := c.client != nil && bytes.Equal(contents, c.contents)
+		c.mu.RUnlock()
+		if unchanged {
+			return nil
+		}
+	}
+
+	var client auth.AuthClient
+	if err == nil {
+		client, err = c.build(contents)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+	if err != nil {
+		return err
+	}
+	c.client = client
+	c.contents = contents
+	return nil
+}
+
+// Err returns the error from the most recent reload, if it failed
+func (c *Client) Err() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.err
+}
+
+// Close stops watching the credentials file
+func (c *Client) Close() error {
+	err := c.watcher.Close()
+	<-c.done
+	return err
+}
+
+func (c *Client) current() auth.AuthClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.client
+}
+
+func (c *Client) watch() {
+	defer close(c.done)
+	for {
+		select {
+		case _, ok := <-c.watcher.Events:
+			if !ok {
+				return
+			}
+			// Any change in the directory may have changed the file, and Reload
+			// ignores changes which leave its contents untouched.
+			_ = c.Reload()
+		case err, ok := <-c.watcher.Errors:
+			if !ok {
+				return
+			}
+			c.mu.Lock()
+			c.err = err
+			c.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package reload_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/apikey"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/auth/reload"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		dir      string
+		filename string
+		client   *reload.Client
+	)
+
+	writeFile := func(contents string) {
+		// Write and rename, so the watcher never sees a half written file
+		tmp := filepath.Join(dir, ".tmp")
+		Expect(os.WriteFile(tmp, []byte(contents), 0600)).To(Succeed())
+		Expect(os.Rename(tmp, filename)).To(Succeed())
+	}
+
+	apiKey := func() string {
+		request := http.Request{Header: http.Header{}}
+		Expect(client.Authenticate(&request)).To(Succeed())
+		return request.Header.Get(apikey.APIKeyHeader)
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		filename = filepath.Join(dir, "apikey")
+		writeFile("first-key\n")
+	})
+
+	AfterEach(func() {
+		if client != nil {
+			Expect(client.Close()).To(Succeed())
+			client = nil
+		}
+	})
+
+	It("Authenticates with the credentials from the file", func() {
+		var err error
+		client, err = reload.NewClient(filename, reload.APIKeyBuilder())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiKey()).To(Equal("first-key"))
+	})
+
+	It("Rebuilds the auth client when the file changes", func() {
+		var err error
+		client, err = reload.NewClient(filename, reload.APIKeyBuilder())
+		Expect(err).NotTo(HaveOccurred())
+
+		writeFile("second-key\n")
+		Eventually(apiKey).Should(Equal("second-key"))
+	})
+
+	It("Follows Kubernetes style symlink swaps", func() {
+		// Kubernetes mounts secrets as symlinks into a ..data directory, which is itself
+		// a symlink that gets swapped atomically when the secret changes
+		Expect(os.Mkdir(filepath.Join(dir, "v1"), 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "v1", "key"), []byte("first-key"), 0600)).To(Succeed())
+		Expect(os.Symlink("v1", filepath.Join(dir, "..data"))).To(Succeed())
+		Expect(os.Symlink(filepath.Join("..data", "key"), filepath.Join(dir, "key"))).To(Succeed())
+
+		var err error
+		client, err = reload.NewClient(filepath.Join(dir, "key"), reload.APIKeyBuilder())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiKey()).To(Equal("first-key"))
+
+		Expect(os.Mkdir(filepath.Join(dir, "v2"), 0700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "v2", "key"), []byte("second-key"), 0600)).To(Succeed())
+		Expect(os.Symlink("v2", filepath.Join(dir, "..data_tmp"))).To(Succeed())
+		Expect(os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data"))).To(Succeed())
+
+		Eventually(apiKey).Should(Equal("second-key"))
+	})
+
+	It("Keeps the old auth client when the new credentials cannot be used", func() {
+		build := func(contents []byte) (auth.AuthClient, error) {
+			if strings.TrimSpace(string(contents)) == "bad" {
+				return nil, errors.New("Bad credentials")
+			}
+			return apikey.NewClient(strings.TrimSpace(string(contents)))
+		}
+
+		var err error
+		client, err = reload.NewClient(filename, build)
+		Expect(err).NotTo(HaveOccurred())
+
+		writeFile("bad")
+		Eventually(client.Err).Should(MatchError("Bad credentials"))
+		Expect(apiKey()).To(Equal("first-key"))
+
+		writeFile("second-key")
+		Eventually(apiKey).Should(Equal("second-key"))
+		Expect(client.Err()).NotTo(HaveOccurred())
+	})
+
+	It("Lets in-flight requests finish with the old auth client", func() {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		var blocked int32
+		build := func(contents []byte) (auth.AuthClient, error) {
+			key := strings.TrimSpace(string(contents))
+			fake := &authfakes.FakeAuthClient{}
+			fake.AuthenticateStub = func(r *http.Request) error {
+				if key == "first-key" {
+					// Only the first request blocks, later ones may still race the reload
+					if atomic.CompareAndSwapInt32(&blocked, 0, 1) {
+						close(started)
+						<-release
+					}
+				}
+				r.Header.Set(apikey.APIKeyHeader, key)
+				return nil
+			}
+			return fake, nil
+		}
+
+		var err error
+		client, err = reload.NewClient(filename, build)
+		Expect(err).NotTo(HaveOccurred())
+
+		inFlight := http.Request{Header: http.Header{}}
+		done := make(chan error)
+		go func() {
+			done <- client.Authenticate(&inFlight)
+		}()
+		<-started
+
+		writeFile("second-key")
+		Eventually(apiKey).Should(Equal("second-key"))
+
+		close(release)
+		Expect(<-done).To(Succeed())
+		Expect(inFlight.Header.Get(apikey.APIKeyHeader)).To(Equal("first-key"))
+	})
+
+	It("Invalidates a refreshable auth client", func() {
+		fake := &authfakes.FakeRefreshableAuthClient{}
+		var err error
+		client, err = reload.NewClient(filename, func([]byte) (auth.AuthClient, error) {
+			return fake, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		client.Invalidate()
+		Expect(fake.InvalidateCallCount()).To(Equal(1))
+	})
+
+	It("Errors when the file cannot be read", func() {
+		var err error
+		client, err = reload.NewClient(filepath.Join(dir, "missing"), reload.APIKeyBuilder())
+		Expect(err).To(HaveOccurred())
+		Expect(client).To(BeNil())
+	})
+
+	It("Builds IAM and local clients", func() {
+		iamClient, err := reload.IAMBuilder("")([]byte("some-key\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(iamClient).NotTo(BeNil())
+
+		localClient, err := reload.LocalBuilder("http://foo.bar", "user")([]byte("password\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(localClient).NotTo(BeNil())
+	})
+})
@@ -1,12 +1,16 @@
 package client
 
 import (
+	"errors"
+
 	"github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/actions/channels/channelsfakes"
 	"github.com/IBM/satcon-client-go/client/actions/clusters"
 	"github.com/IBM/satcon-client-go/client/actions/clusters/clustersfakes"
 	"github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/actions/groups/groupsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys/orgkeysfakes"
 	"github.com/IBM/satcon-client-go/client/actions/resources"
 	"github.com/IBM/satcon-client-go/client/actions/resources/resourcesfakes"
 	"github.com/IBM/satcon-client-go/client/actions/subscriptions"
@@ -19,7 +23,9 @@ import (
 	"github.com/IBM/satcon-client-go/client/web"
 )
 
-//SatCon struct for satellite configuration entities
+//SatCon struct for satellite configuration entities.  It does not include the
+//agent operations, which need an org key rather than the user's credentials; create
+//those with agent.NewClient and an orgkey.RazeeOrgKeyAuthClient.
 type SatCon struct {
 	Channels      channels.ChannelService
 	Clusters      clusters.ClusterService
@@ -28,6 +34,9 @@ type SatCon struct {
 	Subscriptions subscriptions.SubscriptionService
 	Versions      versions.VersionService
 	Users         users.UserService
+	OrgKeys       orgkeys.OrgKeyService
+
+	authClient auth.AuthClient
 }
 
 //New creates new SatCon clients
@@ -42,6 +51,8 @@ func NewWithCustomHTTPClient(endpointURL string, httpClient web.HTTPClient, auth
 		s   SatCon
 	)
 
+	s.authClient = authClient
+
 	s.Channels, err = channels.NewClient(endpointURL, httpClient, authClient)
 	if err != nil {
 		return SatCon{}, err
@@ -70,10 +81,55 @@ func NewWithCustomHTTPClient(endpointURL string, httpClient web.HTTPClient, auth
 	if err != nil {
 		return SatCon{}, err
 	}
+	s.OrgKeys, err = orgkeys.NewClient(endpointURL, httpClient, authClient)
+	if err != nil {
+		return SatCon{}, err
+	}
+
+	return s, nil
+}
+
+// NewWithEndpointPool creates new SatCon clients which send their requests to the
+// endpoints in the supplied pool, failing over between them as described by
+// web.EndpointPool.
+func NewWithEndpointPool(pool *web.EndpointPool, httpClient web.HTTPClient, authClient auth.AuthClient) (SatCon, error) {
+	if pool == nil {
+		return SatCon{}, errors.New("Must supply an endpoint pool")
+	}
+
+	s, err := NewWithCustomHTTPClient(pool.Primary(), httpClient, authClient)
+	if err != nil {
+		return SatCon{}, err
+	}
+
+	for _, service := range s.services() {
+		if c, ok := service.(endpointPoolUser); ok {
+			c.UseEndpointPool(pool)
+		}
+	}
 
 	return s, nil
 }
 
+// endpointPoolUser is implemented by every service client through its embedded web.SatConClient
+type endpointPoolUser interface {
+	UseEndpointPool(pool *web.EndpointPool)
+}
+
+// services returns all of the service clients held by s
+func (s SatCon) services() []interface{} {
+	return []interface{}{
+		s.Channels,
+		s.Clusters,
+		s.Groups,
+		s.Resources,
+		s.Subscriptions,
+		s.Versions,
+		s.Users,
+		s.OrgKeys,
+	}
+}
+
 // NewTesting is a convenience method which creates a client using only fakes
 // for the type-specific service interfaces.  See the counterfeiter documentation
 // for how to customize these fakes e.g. to provide stub implementations, etc.
@@ -86,6 +142,7 @@ func NewTesting(endpointURL string, httpClient web.HTTPClient) SatCon {
 	s.Subscriptions = &subscriptionsfakes.FakeSubscriptionService{}
 	s.Versions = &versionsfakes.FakeVersionService{}
 	s.Users = &usersfakes.FakeUserService{}
+	s.OrgKeys = &orgkeysfakes.FakeOrgKeyService{}
 
 	return s
 }
@@ -6,13 +6,23 @@ import (
 
 	. "github.com/IBM/satcon-client-go/client"
 
+	"github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/actions/channels/channelsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/clusters"
 	"github.com/IBM/satcon-client-go/client/actions/clusters/clustersfakes"
+	"github.com/IBM/satcon-client-go/client/actions/groups"
 	"github.com/IBM/satcon-client-go/client/actions/groups/groupsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys/orgkeysfakes"
+	"github.com/IBM/satcon-client-go/client/actions/resources"
 	"github.com/IBM/satcon-client-go/client/actions/resources/resourcesfakes"
+	"github.com/IBM/satcon-client-go/client/actions/subscriptions"
 	"github.com/IBM/satcon-client-go/client/actions/subscriptions/subscriptionsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/users"
+	"github.com/IBM/satcon-client-go/client/actions/versions"
 	"github.com/IBM/satcon-client-go/client/actions/versions/versionsfakes"
 	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/web"
 )
 
 var _ = Describe("Client", func() {
@@ -38,6 +48,7 @@ var _ = Describe("Client", func() {
 			Expect(s.Resources).NotTo(BeNil())
 			Expect(s.Subscriptions).NotTo(BeNil())
 			Expect(s.Versions).NotTo(BeNil())
+			Expect(s.OrgKeys).NotTo(BeNil())
 		})
 
 		It("Errors when endpointURL is empty", func() {
@@ -53,6 +64,40 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("NewWithEndpointPool", func() {
+		var (
+			iamClient *iam.Client
+			pool      *web.EndpointPool
+			err       error
+		)
+
+		BeforeEach(func() {
+			iamClient, err = iam.NewIAMClient("some_key", "")
+			Expect(err).NotTo(HaveOccurred())
+			pool, err = web.NewEndpointPool("https://primary", "https://secondary")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Creates clients which use the endpoint pool", func() {
+			s, err := NewWithEndpointPool(pool, nil, iamClient.Client)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Channels.(*channels.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Clusters.(*clusters.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Groups.(*groups.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Resources.(*resources.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Subscriptions.(*subscriptions.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Versions.(*versions.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Users.(*users.Client).EndpointPool).To(Equal(pool))
+			Expect(s.OrgKeys.(*orgkeys.Client).EndpointPool).To(Equal(pool))
+			Expect(s.Channels.(*channels.Client).Endpoint).To(Equal("https://primary"))
+		})
+
+		It("Errors when the pool is nil", func() {
+			_, err := NewWithEndpointPool(nil, nil, iamClient.Client)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("NewTesting", func() {
 		var (
 			ch *channelsfakes.FakeChannelService
@@ -80,6 +125,7 @@ var _ = Describe("Client", func() {
 			Expect(sc.Resources).To(BeAssignableToTypeOf(re))
 			Expect(sc.Subscriptions).To(BeAssignableToTypeOf(su))
 			Expect(sc.Versions).To(BeAssignableToTypeOf(ve))
+			Expect(sc.OrgKeys).To(BeAssignableToTypeOf(&orgkeysfakes.FakeOrgKeyService{}))
 		})
 	})
 })
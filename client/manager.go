@@ -0,0 +1,287 @@
+package client
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web"
+)
+
+// CredentialProvider returns the auth client to use for an organization.  It is
+// called at most once per organization until the organization is forgotten.
+type CredentialProvider func(orgID string) (auth.AuthClient, error)
+
+// IAMCredentials returns a CredentialProvider which uses the IAM API key mapped to
+// each organization (IBM Cloud account ID).  An empty iamURL uses the production IAM endpoint.
+func IAMCredentials(apiKeys map[string]string, iamURL string) CredentialProvider {
+	return func(orgID string) (auth.AuthClient, error) {
+		apiKey, ok := apiKeys[orgID]
+		if !ok {
+			return nil, fmt.Errorf("No credentials for organization %s", orgID)
+		}
+		return iam.NewIAMClient(apiKey, iamURL)
+	}
+}
+
+// TenantMetrics counts how an organization's credentials have been used
+type TenantMetrics struct {
+	// Authentications is the number of requests authenticated
+	Authentications uint64
+	// TokenChanges is the number of times the credentials added to requests changed
+	// after the first request was authenticated, i.e. how often the token was refreshed
+	TokenChanges uint64
+	// Invalidations is the number of times the credentials were rejected and thrown away
+	Invalidations uint64
+	// Errors is the number of requests which could not be authenticated
+	Errors uint64
+}
+
+// Manager holds the SatCon clients of many organizations, each authenticated with
+// its own credentials.  The auth client and SatCon services of an organization are
+// built the first time they are needed and cached from then on.  A Manager is safe
+// to use from multiple goroutines.
+type Manager struct {
+	endpointURL string
+	httpClient  web.HTTPClient
+	credentials CredentialProvider
+
+	mu       sync.Mutex
+	tenants  map[string]*tenant
+	building map[string]*tenantBuild
+}
+
+// tenantBuild is an organization's clients being built.  Callers which need them
+// meanwhile wait on done rather than calling the CredentialProvider again.
+type tenantBuild struct {
+	done   chan struct{}
+	tenant *tenant
+	err    error
+}
+
+type tenant struct {
+	satcon     SatCon
+	org        *OrgSatCon
+	authClient *meteredAuthClient
+}
+
+// NewManager returns a Manager whose organizations' auth clients are supplied by
+// credentials.  A nil httpClient uses http.DefaultClient.
+func NewManager(endpointURL string, httpClient web.HTTPClient, credentials CredentialProvider) (*Manager, error) {
+	if endpointURL == "" {
+		return nil, errors.New("Must supply a valid endpoint URL")
+	}
+	if credentials == nil {
+		return nil, errors.New("Must supply a credential provider")
+	}
+
+	return &Manager{
+		endpointURL: endpointURL,
+		httpClient:  httpClient,
+		credentials: credentials,
+		tenants:     map[string]*tenant{},
+		building:    map[string]*tenantBuild{},
+	}, nil
+}
+
+// SatCon returns the SatCon services authenticated with orgID's credentials
+func (m *Manager) SatCon(orgID string) (SatCon, error) {
+	t, err := m.tenant(orgID)
+	if err != nil {
+		return SatCon{}, err
+	}
+	return t.satcon, nil
+}
+
+// ForOrg returns a handle bound to orgID and authenticated with its credentials.
+// Unlike SatCon.ForOrg, the organization is not validated against Users.Me(), since
+// the credentials were configured for it.
+func (m *Manager) ForOrg(orgID string) (*OrgSatCon, error) {
+	t, err := m.tenant(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return t.org, nil
+}
+
+// Forget drops the cached clients of orgID, e.g. after its credentials have been
+// rotated.  They are rebuilt the next time they are needed.
+func (m *Manager) Forget(orgID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tenants, orgID)
+	delete(m.building, orgID)
+}
+
+// OrgIDs returns the organizations the Manager currently holds clients for
+func (m *Manager) OrgIDs() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	orgIDs := make([]string, 0, len(m.tenants))
+	for orgID := range m.tenants {
+		orgIDs = append(orgIDs, orgID)
+	}
+	sort.Strings(orgIDs)
+	return orgIDs
+}
+
+// Metrics returns the credential usage of every organization the Manager holds clients for
+func (m *Manager) Metrics() map[string]TenantMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics := make(map[string]TenantMetrics, len(m.tenants))
+	for orgID, t := range m.tenants {
+		metrics[orgID] = t.authClient.metrics()
+	}
+	return metrics
+}
+
+func (m *Manager) tenant(orgID string) (*tenant, error) {
+	if orgID == "" {
+		return nil, errors.New("Must supply a valid organization ID")
+	}
+
+	// The clients are built without holding the lock, since the CredentialProvider
+	// may be slow, and concurrent callers for the same organization share one build
+	m.mu.Lock()
+	if t, ok := m.tenants[orgID]; ok {
+		m.mu.Unlock()
+		return t, nil
+	}
+	if b, ok := m.building[orgID]; ok {
+		m.mu.Unlock()
+		<-b.done
+		return b.tenant, b.err
+	}
+	b := &tenantBuild{done: make(chan struct{})}
+	m.building[orgID] = b
+	m.mu.Unlock()
+
+	b.tenant, b.err = m.buildTenant(orgID)
+
+	m.mu.Lock()
+	// Forget may have dropped the build meanwhile, in which case it is not cached
+	if m.building[orgID] == b {
+		delete(m.building, orgID)
+		if b.err == nil {
+			m.tenants[orgID] = b.tenant
+		}
+	}
+	m.mu.Unlock()
+	close(b.done)
+
+	return b.tenant, b.err
+}
+
+func (m *Manager) buildTenant(orgID string) (*tenant, error) {
+	authClient, err := m.credentials(orgID)
+	if err != nil {
+		return nil, err
+	}
+	if authClient == nil {
+		return nil, fmt.Errorf("No credentials for organization %s", orgID)
+	}
+
+	metered := &meteredAuthClient{client: authClient}
+	s, err := NewWithCustomHTTPClient(m.endpointURL, m.httpClient, metered)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenant{
+		satcon:     s,
+		org:        newOrgSatCon(s, types.OrgID(orgID)),
+		authClient: metered,
+	}, nil
+}
+
+// meteredAuthClient counts how its auth client is used.  It passes Invalidate and
+// Identity through, so it is as capable as the client it wraps.
+type meteredAuthClient struct {
+	client auth.AuthClient
+
+	authentications uint64
+	tokenChanges    uint64
+	invalidations   uint64
+	authErrors      uint64
+
+	// credentials is a hash of the headers last added to a request, so that the
+	// token itself is not kept around
+	mu             sync.Mutex
+	credentials    [sha256.Size]byte
+	hasCredentials bool
+}
+
+func (m *meteredAuthClient) Authenticate(request *http.Request) error {
+	before := request.Header.Clone()
+	if err := m.client.Authenticate(request); err != nil {
+		atomic.AddUint64(&m.authErrors, 1)
+		return err
+	}
+	atomic.AddUint64(&m.authentications, 1)
+
+	credentials := sha256.Sum256([]byte(addedHeaders(before, request.Header)))
+	m.mu.Lock()
+	if m.hasCredentials && credentials != m.credentials {
+		atomic.AddUint64(&m.tokenChanges, 1)
+	}
+	m.credentials = credentials
+	m.hasCredentials = true
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *meteredAuthClient) Invalidate() {
+	atomic.AddUint64(&m.invalidations, 1)
+	if refreshable, ok := m.client.(auth.RefreshableAuthClient); ok {
+		refreshable.Invalidate()
+	}
+}
+
+func (m *meteredAuthClient) Identity() (*auth.Identity, error) {
+	if provider, ok := m.client.(auth.IdentityProvider); ok {
+		return provider.Identity()
+	}
+	return nil, auth.ErrNoIdentity
+}
+
+func (m *meteredAuthClient) metrics() TenantMetrics {
+	return TenantMetrics{
+		Authentications: atomic.LoadUint64(&m.authentications),
+		TokenChanges:    atomic.LoadUint64(&m.tokenChanges),
+		Invalidations:   atomic.LoadUint64(&m.invalidations),
+		Errors:          atomic.LoadUint64(&m.authErrors),
+	}
+}
+
+// addedHeaders describes the headers which differ between before and after, i.e.
+// the credentials an auth client added to a request.
+func addedHeaders(before, after http.Header) string {
+	keys := make([]string, 0, len(after))
+	for key := range after {
+		if strings.Join(before[key], ",") != strings.Join(after[key], ",") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteString(key)
+		b.WriteString(": ")
+		b.WriteString(strings.Join(after[key], ","))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,243 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client"
+
+	"github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/apikey"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		m             *Manager
+		h             *webfakes.FakeHTTPClient
+		providerCalls map[string]int
+		providerMu    sync.Mutex
+		credentials   CredentialProvider
+	)
+
+	BeforeEach(func() {
+		providerCalls = map[string]int{}
+		credentials = func(orgID string) (auth.AuthClient, error) {
+			providerMu.Lock()
+			defer providerMu.Unlock()
+			providerCalls[orgID]++
+			if orgID == "unknown" {
+				return nil, fmt.Errorf("No credentials for organization %s", orgID)
+			}
+			return apikey.NewClient("key-for-" + orgID)
+		}
+
+		h = &webfakes.FakeHTTPClient{}
+		h.DoStub = func(*http.Request) (*http.Response, error) {
+			body, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{groups.QueryGroups: types.GroupList{}}})
+			return &http.Response{Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+		}
+
+		var err error
+		m, err = NewManager("https://foo.bar", h, credentials)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Routes calls through the organization's credentials", func() {
+		o, err := m.ForOrg("org1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(o.OrgID()).To(Equal(types.OrgID("org1")))
+
+		_, err = o.Groups.Groups()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.DoArgsForCall(0).Header.Get(apikey.APIKeyHeader)).To(Equal("key-for-org1"))
+
+		o2, err := m.ForOrg("org2")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = o2.Groups.Groups()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.DoArgsForCall(1).Header.Get(apikey.APIKeyHeader)).To(Equal("key-for-org2"))
+	})
+
+	It("Builds the clients of an organization only once", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := m.SatCon("org1")
+				Expect(err).NotTo(HaveOccurred())
+			}()
+		}
+		wg.Wait()
+
+		o1, _ := m.ForOrg("org1")
+		o2, _ := m.ForOrg("org1")
+		Expect(o1).To(BeIdenticalTo(o2))
+		Expect(providerCalls["org1"]).To(Equal(1))
+		Expect(m.OrgIDs()).To(Equal([]string{"org1"}))
+	})
+
+	It("Does not block other organizations while one is being built", func() {
+		entered := make(chan struct{})
+		release := make(chan struct{})
+		slow, err := NewManager("https://foo.bar", h, func(orgID string) (auth.AuthClient, error) {
+			if orgID == "slow" {
+				close(entered)
+				<-release
+			}
+			return credentials(orgID)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = slow.ForOrg("org1")
+		Expect(err).NotTo(HaveOccurred())
+
+		built := make(chan *OrgSatCon, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				defer GinkgoRecover()
+				o, err := slow.ForOrg("slow")
+				Expect(err).NotTo(HaveOccurred())
+				built <- o
+			}()
+		}
+		Eventually(entered).Should(BeClosed())
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			_, err := slow.ForOrg("org1")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = slow.ForOrg("org2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slow.Metrics()).To(HaveLen(2))
+		}()
+		Eventually(done).Should(BeClosed())
+
+		close(release)
+		var o1, o2 *OrgSatCon
+		Eventually(built).Should(Receive(&o1))
+		Eventually(built).Should(Receive(&o2))
+		Expect(o1).To(BeIdenticalTo(o2))
+		Expect(providerCalls["slow"]).To(Equal(1))
+		Expect(slow.OrgIDs()).To(Equal([]string{"org1", "org2", "slow"}))
+	})
+
+	It("Rebuilds the clients of a forgotten organization", func() {
+		_, err := m.ForOrg("org1")
+		Expect(err).NotTo(HaveOccurred())
+		m.Forget("org1")
+		Expect(m.OrgIDs()).To(BeEmpty())
+
+		_, err = m.ForOrg("org1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(providerCalls["org1"]).To(Equal(2))
+	})
+
+	It("Bubbles up credential errors without caching them", func() {
+		_, err := m.ForOrg("unknown")
+		Expect(err).To(MatchError("No credentials for organization unknown"))
+		_, err = m.SatCon("unknown")
+		Expect(err).To(HaveOccurred())
+		Expect(providerCalls["unknown"]).To(Equal(2))
+	})
+
+	It("Errors when the orgID is empty", func() {
+		_, err := m.ForOrg("")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("Metrics", func() {
+		var (
+			fake  *authfakes.FakeRefreshableAuthClient
+			token int
+		)
+
+		BeforeEach(func() {
+			token = 1
+			fake = &authfakes.FakeRefreshableAuthClient{}
+			fake.AuthenticateStub = func(r *http.Request) error {
+				r.Header.Set("Authorization", fmt.Sprintf("Bearer token-%d", token))
+				return nil
+			}
+
+			var err error
+			m, err = NewManager("https://foo.bar", h, func(string) (auth.AuthClient, error) {
+				return fake, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Counts authentications and token changes", func() {
+			o, err := m.ForOrg("org1")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _ = o.Groups.Groups()
+			_, _ = o.Groups.Groups()
+			token = 2
+			_, _ = o.Groups.Groups()
+
+			Expect(m.Metrics()).To(Equal(map[string]TenantMetrics{
+				"org1": {Authentications: 3, TokenChanges: 1},
+			}))
+		})
+
+		It("Does not count the first token as a change", func() {
+			o, err := m.ForOrg("org1")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _ = o.Groups.Groups()
+
+			Expect(m.Metrics()["org1"].TokenChanges).To(BeZero())
+		})
+
+		It("Counts invalidations and errors", func() {
+			s, err := m.SatCon("org1")
+			Expect(err).NotTo(HaveOccurred())
+
+			h.DoStub = nil
+			h.DoReturnsOnCall(0, &http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil)
+			h.DoReturnsOnCall(1, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil)
+			_, _ = s.Groups.Groups("org1")
+			Expect(fake.InvalidateCallCount()).To(Equal(1))
+
+			fake.AuthenticateStub = nil
+			fake.AuthenticateReturns(errors.New("No token"))
+			_, _ = s.Groups.Groups("org1")
+
+			metrics := m.Metrics()["org1"]
+			Expect(metrics.Invalidations).To(Equal(uint64(1)))
+			Expect(metrics.Errors).To(Equal(uint64(1)))
+		})
+	})
+
+	Describe("IAMCredentials", func() {
+		It("Builds IAM clients for the configured organizations", func() {
+			provider := IAMCredentials(map[string]string{"org1": "some_key"}, "")
+			authClient, err := provider("org1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authClient).NotTo(BeNil())
+
+			_, err = provider("org2")
+			Expect(err).To(MatchError("No credentials for organization org2"))
+		})
+	})
+
+	It("Errors without a credential provider", func() {
+		_, err := NewManager("https://foo.bar", nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
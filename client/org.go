@@ -0,0 +1,403 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/satcon-client-go/client/actions/channels"
+	"github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/actions/groups"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys"
+	"github.com/IBM/satcon-client-go/client/actions/resources"
+	"github.com/IBM/satcon-client-go/client/actions/subscriptions"
+	"github.com/IBM/satcon-client-go/client/actions/users"
+	"github.com/IBM/satcon-client-go/client/actions/versions"
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// OrgSatCon is a SatCon handle bound to a single organization.  It exposes the
+// same operations as SatCon, minus the orgID argument.  An OrgSatCon holds no
+// mutable state and is safe to share across goroutines.
+type OrgSatCon struct {
+	Channels      OrgChannels
+	Clusters      OrgClusters
+	Groups        OrgGroups
+	Resources     OrgResources
+	Subscriptions OrgSubscriptions
+	Versions      OrgVersions
+	Users         users.UserService
+	OrgKeys       OrgOrgKeys
+
+	orgID types.OrgID
+}
+
+// ForOrg returns a handle bound to orgID.  The organization is validated once,
+// by confirming that the authenticated user (as reported by Users.Me()) belongs to it.
+func (s SatCon) ForOrg(orgID types.OrgID) (*OrgSatCon, error) {
+	if orgID == "" {
+		return nil, errors.New("Must supply a valid organization ID")
+	}
+
+	if s.Users == nil {
+		return nil, errors.New("Cannot validate organization without a user service")
+	}
+
+	me, err := s.Users.Me()
+	if err != nil {
+		return nil, err
+	}
+
+	if me == nil || me.OrgId != string(orgID) {
+		return nil, fmt.Errorf("Authenticated user is not a member of organization %s", orgID)
+	}
+
+	return newOrgSatCon(s, orgID), nil
+}
+
+// DefaultOrgID returns the organization of the authenticated user.  It is decoded
+// from the auth client's token if the auth client is an auth.IdentityProvider,
+// and otherwise asked of Users.Me().
+func (s SatCon) DefaultOrgID() (types.OrgID, error) {
+	if provider, ok := s.authClient.(auth.IdentityProvider); ok {
+		identity, err := provider.Identity()
+		if err == nil && identity.OrgID != "" {
+			return types.OrgID(identity.OrgID), nil
+		}
+	}
+
+	if s.Users == nil {
+		return "", errors.New("Cannot determine organization without a user service")
+	}
+
+	me, err := s.Users.Me()
+	if err != nil {
+		return "", err
+	}
+
+	if me == nil || me.OrgId == "" {
+		return "", errors.New("Authenticated user does not belong to an organization")
+	}
+
+	return types.OrgID(me.OrgId), nil
+}
+
+// ForDefaultOrg returns a handle bound to the organization of the authenticated
+// user, see DefaultOrgID.  The organization is validated as for ForOrg.
+func (s SatCon) ForDefaultOrg() (*OrgSatCon, error) {
+	orgID, err := s.DefaultOrgID()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ForOrg(orgID)
+}
+
+func newOrgSatCon(s SatCon, id types.OrgID) *OrgSatCon {
+	orgID := string(id)
+	return &OrgSatCon{
+		Channels:      OrgChannels{orgID: orgID, service: s.Channels},
+		Clusters:      OrgClusters{orgID: orgID, service: s.Clusters},
+		Groups:        OrgGroups{orgID: orgID, service: s.Groups},
+		Resources:     OrgResources{orgID: orgID, service: s.Resources},
+		Subscriptions: OrgSubscriptions{orgID: orgID, service: s.Subscriptions},
+		Versions:      OrgVersions{orgID: orgID, service: s.Versions},
+		Users:         s.Users,
+		OrgKeys:       OrgOrgKeys{orgID: orgID, service: s.OrgKeys},
+		orgID:         id,
+	}
+}
+
+// OrgID returns the organization the handle is bound to.
+func (o *OrgSatCon) OrgID() types.OrgID {
+	return o.orgID
+}
+
+// CleanupInactiveClusters ungroups and deletes the organization's clusters whose
+// agent has been inactive for opts.InactiveDays, see clusters.CleanupInactiveClusters.
+func (o *OrgSatCon) CleanupInactiveClusters(opts clusters.CleanupOptions) (*clusters.CleanupReport, error) {
+	return clusters.CleanupInactiveClusters(o.Clusters.service, o.Groups.service, string(o.orgID), opts)
+}
+
+// RegisterClusterWithGroups registers a cluster and adds it to the named groups,
+// see clusters.RegisterClusterWithGroups.
+func (o *OrgSatCon) RegisterClusterWithGroups(registration types.Registration, groupNames []string) (*clusters.RegisterClusterResponseDataDetails, error) {
+	return clusters.RegisterClusterWithGroups(o.Clusters.service, o.Groups.service, string(o.orgID), registration, groupNames)
+}
+
+// RegisterInventory registers the inventory clusters, adds them to their groups and
+// writes their registration URLs, see clusters.RegisterInventory.
+func (o *OrgSatCon) RegisterInventory(ctx context.Context, inventory []clusters.InventoryEntry, opts clusters.BulkRegistrationOptions) (*clusters.BulkRegistrationReport, error) {
+	return clusters.RegisterInventory(ctx, o.Clusters.service, o.Groups.service, string(o.orgID), inventory, opts)
+}
+
+// idStrings converts typed IDs to the plain strings the services take
+func idStrings[T ~string](ids []T) []string {
+	if ids == nil {
+		return nil
+	}
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = string(id)
+	}
+	return strs
+}
+
+// OrgChannels performs channels.ChannelService operations for a single organization.
+type OrgChannels struct {
+	orgID   string
+	service channels.ChannelService
+}
+
+func (c OrgChannels) AddChannel(name string) (*channels.AddChannelResponseDataDetails, error) {
+	return c.service.AddChannel(c.orgID, name)
+}
+
+func (c OrgChannels) Channel(uuid types.ChannelUUID) (*types.Channel, error) {
+	return c.service.Channel(c.orgID, string(uuid))
+}
+
+func (c OrgChannels) ChannelByName(channelName string) (*types.Channel, error) {
+	return c.service.ChannelByName(c.orgID, channelName)
+}
+
+func (c OrgChannels) Channels() (types.ChannelList, error) {
+	return c.service.Channels(c.orgID)
+}
+
+func (c OrgChannels) RemoveChannel(uuid types.ChannelUUID) (*channels.RemoveChannelResponseDataDetails, error) {
+	return c.service.RemoveChannel(c.orgID, string(uuid))
+}
+
+func (c OrgChannels) EditChannel(uuid types.ChannelUUID, opts channels.EditChannelOptions) (*types.Channel, error) {
+	return c.service.EditChannel(c.orgID, string(uuid), opts)
+}
+
+func (c OrgChannels) AddChannelWithOptions(name string, opts channels.AddChannelOptions) (*channels.AddChannelResponseDataDetails, error) {
+	return c.service.AddChannelWithOptions(c.orgID, name, opts)
+}
+
+func (c OrgChannels) ChannelsByTags(tags []string) (types.ChannelList, error) {
+	return c.service.ChannelsByTags(c.orgID, tags)
+}
+
+// OrgClusters performs clusters.ClusterService operations for a single organization.
+type OrgClusters struct {
+	orgID   string
+	service clusters.ClusterService
+}
+
+func (c OrgClusters) RegisterCluster(registration types.Registration) (*clusters.RegisterClusterResponseDataDetails, error) {
+	return c.service.RegisterCluster(c.orgID, registration)
+}
+
+func (c OrgClusters) Clusters() (types.ClusterList, error) {
+	return c.service.ClustersByOrgID(c.orgID)
+}
+
+func (c OrgClusters) ClusterByName(clusterName string) (*types.Cluster, error) {
+	return c.service.ClusterByName(c.orgID, clusterName)
+}
+
+func (c OrgClusters) ClusterByClusterID(clusterID types.ClusterID) (*types.Cluster, error) {
+	return c.service.ClusterByClusterID(c.orgID, string(clusterID))
+}
+
+func (c OrgClusters) ClusterSearch(filter string, limit int) (types.ClusterList, error) {
+	return c.service.ClusterSearch(c.orgID, filter, limit)
+}
+
+func (c OrgClusters) ClusterCountByKubeVersion() ([]types.ClusterCountByKubeVersion, error) {
+	return c.service.ClusterCountByKubeVersion(c.orgID)
+}
+
+func (c OrgClusters) DeleteClusterByClusterID(clusterID types.ClusterID) (*clusters.DeleteClustersResponseDataDetails, error) {
+	return c.service.DeleteClusterByClusterID(c.orgID, string(clusterID))
+}
+
+func (c OrgClusters) DeleteClusters() (*clusters.DeleteClustersResponseDataDetails, error) {
+	return c.service.DeleteClusters(c.orgID)
+}
+
+func (c OrgClusters) InactiveClusters() (types.ClusterList, error) {
+	return c.service.InactiveClusters(c.orgID)
+}
+
+func (c OrgClusters) EnableRegistrationURL(clusterID types.ClusterID) (*clusters.EnableRegistrationURLResponseDataDetails, error) {
+	return c.service.EnableRegistrationURL(c.orgID, string(clusterID))
+}
+
+// OrgGroups performs groups.GroupService operations for a single organization.
+type OrgGroups struct {
+	orgID   string
+	service groups.GroupService
+}
+
+func (g OrgGroups) Groups() (types.GroupList, error) {
+	return g.service.Groups(g.orgID)
+}
+
+func (g OrgGroups) GroupByName(name string) (*types.Group, error) {
+	return g.service.GroupByName(g.orgID, name)
+}
+
+func (g OrgGroups) AddGroup(name string) (*groups.AddGroupResponseDataDetails, error) {
+	return g.service.AddGroup(g.orgID, name)
+}
+
+func (g OrgGroups) RemoveGroup(uuid types.GroupUUID) (*groups.RemoveGroupResponseDataDetails, error) {
+	return g.service.RemoveGroup(g.orgID, string(uuid))
+}
+
+func (g OrgGroups) RemoveGroupByName(name string) (*groups.RemoveGroupByNameResponseDataDetails, error) {
+	return g.service.RemoveGroupByName(g.orgID, name)
+}
+
+func (g OrgGroups) GroupClusters(uuid types.GroupUUID, clusterIDs []types.ClusterID) (*groups.GroupClustersResponseDataDetails, error) {
+	return g.service.GroupClusters(g.orgID, string(uuid), idStrings(clusterIDs))
+}
+
+func (g OrgGroups) UnGroupClusters(uuid types.GroupUUID, clusterIDs []types.ClusterID) (*groups.UnGroupClustersResponseDataDetails, error) {
+	return g.service.UnGroupClusters(g.orgID, string(uuid), idStrings(clusterIDs))
+}
+
+func (g OrgGroups) Group(uuid types.GroupUUID) (*types.Group, error) {
+	return g.service.Group(g.orgID, string(uuid))
+}
+
+func (g OrgGroups) AssignClusterGroups(groupUUIDs []types.GroupUUID, clusterIDs []types.ClusterID) (*groups.AssignClusterGroupsResponseDataDetails, error) {
+	return g.service.AssignClusterGroups(g.orgID, idStrings(groupUUIDs), idStrings(clusterIDs))
+}
+
+func (g OrgGroups) UnassignClusterGroups(groupUUIDs []types.GroupUUID, clusterIDs []types.ClusterID) (*groups.UnassignClusterGroupsResponseDataDetails, error) {
+	return g.service.UnassignClusterGroups(g.orgID, idStrings(groupUUIDs), idStrings(clusterIDs))
+}
+
+func (g OrgGroups) EditClusterGroups(clusterID types.ClusterID, groupUUIDs []types.GroupUUID) (*groups.EditClusterGroupsResponseDataDetails, error) {
+	return g.service.EditClusterGroups(g.orgID, string(clusterID), idStrings(groupUUIDs))
+}
+
+func (g OrgGroups) EditGroup(uuid types.GroupUUID, opts groups.EditGroupOptions) (*types.Group, error) {
+	return g.service.EditGroup(g.orgID, string(uuid), opts)
+}
+
+func (g OrgGroups) AddGroupWithOptions(name string, opts groups.AddGroupOptions) (*groups.AddGroupResponseDataDetails, error) {
+	return g.service.AddGroupWithOptions(g.orgID, name, opts)
+}
+
+func (g OrgGroups) GroupsByTags(tags []string) (types.GroupList, error) {
+	return g.service.GroupsByTags(g.orgID, tags)
+}
+
+// OrgOrgKeys performs orgkeys.OrgKeyService operations for a single organization.
+type OrgOrgKeys struct {
+	orgID   string
+	service orgkeys.OrgKeyService
+}
+
+func (k OrgOrgKeys) OrgKeys() (types.OrgKeyList, error) {
+	return k.service.OrgKeys(k.orgID)
+}
+
+func (k OrgOrgKeys) AddOrgKey(name string, primary bool) (*orgkeys.AddOrgKeyResponseDataDetails, error) {
+	return k.service.AddOrgKey(k.orgID, name, primary)
+}
+
+func (k OrgOrgKeys) EditOrgKey(uuid, name string, primary *bool) (*orgkeys.EditOrgKeyResponseDataDetails, error) {
+	return k.service.EditOrgKey(k.orgID, uuid, name, primary)
+}
+
+func (k OrgOrgKeys) RemoveOrgKey(uuid string, forceDeletion bool) (*orgkeys.RemoveOrgKeyResponseDataDetails, error) {
+	return k.service.RemoveOrgKey(k.orgID, uuid, forceDeletion)
+}
+
+// OrgResources performs resources.ResourceService operations for a single organization.
+type OrgResources struct {
+	orgID   string
+	service resources.ResourceService
+}
+
+func (r OrgResources) ResourcesByCluster(clusterID types.ClusterID, filter string, limit int) (*types.ResourceList, error) {
+	return r.service.ResourcesByCluster(r.orgID, string(clusterID), filter, limit)
+}
+
+func (r OrgResources) Resources() (*types.ResourceList, error) {
+	return r.service.Resources(r.orgID)
+}
+
+func (r OrgResources) ResourceContent(clusterID types.ClusterID, resourceSelfLink string) (*types.ResourceContentObj, error) {
+	return r.service.ResourceContent(r.orgID, string(clusterID), resourceSelfLink)
+}
+
+// OrgSubscriptions performs subscriptions.SubscriptionService operations for a single organization.
+type OrgSubscriptions struct {
+	orgID   string
+	service subscriptions.SubscriptionService
+}
+
+func (s OrgSubscriptions) AddSubscription(name string, channelUuid types.ChannelUUID, versionUuid types.VersionUUID, groups []string) (*subscriptions.AddSubscriptionResponseDataDetails, error) {
+	return s.service.AddSubscription(s.orgID, name, string(channelUuid), string(versionUuid), groups)
+}
+
+func (s OrgSubscriptions) SetSubscription(subscriptionUuid types.SubscriptionUUID, versionUuid types.VersionUUID) (*subscriptions.SetSubscriptionResponseDataDetails, error) {
+	return s.service.SetSubscription(s.orgID, string(subscriptionUuid), string(versionUuid))
+}
+
+func (s OrgSubscriptions) RemoveSubscription(uuid types.SubscriptionUUID) (*subscriptions.RemoveSubscriptionResponseDataDetails, error) {
+	return s.service.RemoveSubscription(s.orgID, string(uuid))
+}
+
+func (s OrgSubscriptions) Subscriptions() (types.SubscriptionList, error) {
+	return s.service.Subscriptions(s.orgID)
+}
+
+func (s OrgSubscriptions) Subscription(uuid types.SubscriptionUUID) (*types.Subscription, error) {
+	return s.service.Subscription(s.orgID, string(uuid))
+}
+
+func (s OrgSubscriptions) SubscriptionIdsForCluster(clusterID types.ClusterID) ([]string, error) {
+	return s.service.SubscriptionIdsForCluster(s.orgID, string(clusterID))
+}
+
+func (s OrgSubscriptions) AddSubscriptionWithOptions(name string, channelUuid types.ChannelUUID, versionUuid types.VersionUUID, groups []string, opts subscriptions.AddSubscriptionOptions) (*subscriptions.AddSubscriptionResponseDataDetails, error) {
+	return s.service.AddSubscriptionWithOptions(s.orgID, name, string(channelUuid), string(versionUuid), groups, opts)
+}
+
+func (s OrgSubscriptions) EditSubscription(uuid types.SubscriptionUUID, opts subscriptions.EditSubscriptionOptions) (*types.Subscription, error) {
+	return s.service.EditSubscription(s.orgID, string(uuid), opts)
+}
+
+func (s OrgSubscriptions) SubscriptionsByTags(tags []string) (types.SubscriptionList, error) {
+	return s.service.SubscriptionsByTags(s.orgID, tags)
+}
+
+// OrgVersions performs versions.VersionService operations for a single organization.
+type OrgVersions struct {
+	orgID   string
+	service versions.VersionService
+}
+
+func (v OrgVersions) AddChannelVersion(channelUuid types.ChannelUUID, name string, content []byte, description string) (*versions.AddChannelVersionResponseDataDetails, error) {
+	return v.service.AddChannelVersion(v.orgID, string(channelUuid), name, content, description)
+}
+
+func (v OrgVersions) AddRemoteChannelVersion(channelUuid types.ChannelUUID, name string, remote types.VersionRemote, description string) (*versions.AddChannelVersionResponseDataDetails, error) {
+	return v.service.AddRemoteChannelVersion(v.orgID, string(channelUuid), name, remote, description)
+}
+
+func (v OrgVersions) RemoveChannelVersion(uuid types.VersionUUID) (*versions.RemoveChannelVersionResponseDataDetails, error) {
+	return v.service.RemoveChannelVersion(v.orgID, string(uuid))
+}
+
+func (v OrgVersions) ChannelVersion(channelUuid types.ChannelUUID, versionUuid types.VersionUUID) (*types.DeployableVersion, error) {
+	return v.service.ChannelVersion(v.orgID, string(channelUuid), string(versionUuid))
+}
+
+func (v OrgVersions) ChannelVersionByName(channelName, versionName string) (*types.DeployableVersion, error) {
+	return v.service.ChannelVersionByName(v.orgID, channelName, versionName)
+}
+
+func (v OrgVersions) EditChannelVersion(channelUuid types.ChannelUUID, versionUuid types.VersionUUID, description string) (*types.DeployableVersion, error) {
+	return v.service.EditChannelVersion(v.orgID, string(channelUuid), string(versionUuid), description)
+}
@@ -0,0 +1,260 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client"
+
+	"github.com/IBM/satcon-client-go/client/actions/channels"
+	"github.com/IBM/satcon-client-go/client/actions/channels/channelsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/clusters"
+	"github.com/IBM/satcon-client-go/client/actions/clusters/clustersfakes"
+	"github.com/IBM/satcon-client-go/client/actions/groups/groupsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/orgkeys/orgkeysfakes"
+	"github.com/IBM/satcon-client-go/client/actions/resources/resourcesfakes"
+	"github.com/IBM/satcon-client-go/client/actions/subscriptions/subscriptionsfakes"
+	"github.com/IBM/satcon-client-go/client/actions/users/usersfakes"
+	"github.com/IBM/satcon-client-go/client/actions/versions/versionsfakes"
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/authfakes"
+	"github.com/IBM/satcon-client-go/client/types"
+)
+
+// identifyingAuthClient is an auth client which can also identify the user
+type identifyingAuthClient struct {
+	authfakes.FakeAuthClient
+	authfakes.FakeIdentityProvider
+}
+
+var _ = Describe("ForDefaultOrg", func() {
+	var (
+		s          SatCon
+		u          *usersfakes.FakeUserService
+		authClient *identifyingAuthClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		authClient = &identifyingAuthClient{}
+		authClient.IdentityReturns(&auth.Identity{OrgID: "tokenorg"}, nil)
+		s, err = NewWithCustomHTTPClient("https://foo.bar", nil, authClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		u = &usersfakes.FakeUserService{}
+		u.MeReturns(&types.User{Id: "me", OrgId: "tokenorg"}, nil)
+		s.Users = u
+	})
+
+	It("Uses the organization from the auth client's token", func() {
+		orgID, err := s.DefaultOrgID()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orgID).To(Equal(types.OrgID("tokenorg")))
+		Expect(u.MeCallCount()).To(Equal(0))
+	})
+
+	It("Validates the organization against Me()", func() {
+		o, err := s.ForDefaultOrg()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(o.OrgID()).To(Equal(types.OrgID("tokenorg")))
+		Expect(u.MeCallCount()).To(Equal(1))
+	})
+
+	Context("When the user does not belong to the token's organization", func() {
+		BeforeEach(func() {
+			u.MeReturns(&types.User{Id: "me", OrgId: "otherorg"}, nil)
+		})
+
+		It("Errors", func() {
+			o, err := s.ForDefaultOrg()
+			Expect(err).To(MatchError(MatchRegexp("not a member of organization tokenorg")))
+			Expect(o).To(BeNil())
+		})
+	})
+
+	Context("When the token does not identify the organization", func() {
+		BeforeEach(func() {
+			authClient.IdentityReturns(nil, auth.ErrNoIdentity)
+			u.MeReturns(&types.User{Id: "me", OrgId: "meorg"}, nil)
+		})
+
+		It("Uses the organization from Me()", func() {
+			o, err := s.ForDefaultOrg()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(o.OrgID()).To(Equal(types.OrgID("meorg")))
+		})
+
+		It("Errors when Me() has no organization", func() {
+			u.MeReturns(&types.User{Id: "me"}, nil)
+			_, err := s.DefaultOrgID()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When the auth client cannot identify the user", func() {
+		It("Uses the organization from Me()", func() {
+			t := NewTesting("https://foo.bar", nil)
+			t.Users.(*usersfakes.FakeUserService).MeReturns(&types.User{Id: "me", OrgId: "meorg"}, nil)
+			orgID, err := t.DefaultOrgID()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orgID).To(Equal(types.OrgID("meorg")))
+		})
+	})
+})
+
+var _ = Describe("ForOrg", func() {
+	var (
+		orgID string
+		s     SatCon
+		u     *usersfakes.FakeUserService
+	)
+
+	BeforeEach(func() {
+		orgID = "someorg"
+		s = NewTesting("https://foo.bar", nil)
+		u = s.Users.(*usersfakes.FakeUserService)
+		u.MeReturns(&types.User{Id: "me", OrgId: orgID}, nil)
+	})
+
+	It("Returns a handle bound to the organization", func() {
+		o, err := s.ForOrg(types.OrgID(orgID))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(o.OrgID()).To(Equal(types.OrgID(orgID)))
+		Expect(u.MeCallCount()).To(Equal(1))
+	})
+
+	It("Errors when the orgID is empty", func() {
+		o, err := s.ForOrg("")
+		Expect(err).To(HaveOccurred())
+		Expect(o).To(BeNil())
+		Expect(u.MeCallCount()).To(Equal(0))
+	})
+
+	Context("When the user belongs to another organization", func() {
+		BeforeEach(func() {
+			u.MeReturns(&types.User{Id: "me", OrgId: "otherorg"}, nil)
+		})
+
+		It("Errors", func() {
+			o, err := s.ForOrg(types.OrgID(orgID))
+			Expect(err).To(MatchError(MatchRegexp("not a member of organization someorg")))
+			Expect(o).To(BeNil())
+		})
+	})
+
+	Context("When Me() errors", func() {
+		BeforeEach(func() {
+			u.MeReturns(nil, errors.New("Who am I?"))
+		})
+
+		It("Bubbles up the error", func() {
+			_, err := s.ForOrg(types.OrgID(orgID))
+			Expect(err).To(MatchError("Who am I?"))
+		})
+	})
+
+	Describe("OrgSatCon", func() {
+		var o *OrgSatCon
+
+		BeforeEach(func() {
+			var err error
+			o, err = s.ForOrg(types.OrgID(orgID))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Supplies the orgID to every service", func() {
+			_, _ = o.Channels.ChannelByName("chan")
+			orgArg, name := s.Channels.(*channelsfakes.FakeChannelService).ChannelByNameArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(name).To(Equal("chan"))
+
+			_, _ = o.Clusters.Clusters()
+			Expect(s.Clusters.(*clustersfakes.FakeClusterService).ClustersByOrgIDArgsForCall(0)).To(Equal(orgID))
+
+			_, _ = o.Groups.GroupClusters("group-uuid", []types.ClusterID{"c1"})
+			orgArg, uuid, clusters := s.Groups.(*groupsfakes.FakeGroupService).GroupClustersArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(uuid).To(Equal("group-uuid"))
+			Expect(clusters).To(Equal([]string{"c1"}))
+
+			_, _ = o.Groups.EditClusterGroups("c1", []types.GroupUUID{"group-uuid"})
+			orgArg, groupedCluster, groupUUIDs := s.Groups.(*groupsfakes.FakeGroupService).EditClusterGroupsArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(groupedCluster).To(Equal("c1"))
+			Expect(groupUUIDs).To(Equal([]string{"group-uuid"}))
+
+			_, _ = o.Resources.Resources()
+			Expect(s.Resources.(*resourcesfakes.FakeResourceService).ResourcesArgsForCall(0)).To(Equal(orgID))
+
+			_, _ = o.Subscriptions.SubscriptionIdsForCluster("cluster")
+			orgArg, clusterID := s.Subscriptions.(*subscriptionsfakes.FakeSubscriptionService).SubscriptionIdsForClusterArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(clusterID).To(Equal("cluster"))
+
+			_, _ = o.Versions.RemoveChannelVersion("version-uuid")
+			orgArg, uuid = s.Versions.(*versionsfakes.FakeVersionService).RemoveChannelVersionArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(uuid).To(Equal("version-uuid"))
+
+			_, _ = o.Channels.EditChannel("channel-uuid", channels.EditChannelOptions{Name: "renamed"})
+			orgArg, uuid, editOpts := s.Channels.(*channelsfakes.FakeChannelService).EditChannelArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(uuid).To(Equal("channel-uuid"))
+			Expect(editOpts).To(Equal(channels.EditChannelOptions{Name: "renamed"}))
+
+			_, _ = o.Groups.GroupsByTags([]string{"prod"})
+			orgArg, tags := s.Groups.(*groupsfakes.FakeGroupService).GroupsByTagsArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(tags).To(Equal([]string{"prod"}))
+
+			_, _ = o.OrgKeys.RemoveOrgKey("key-uuid", true)
+			orgArg, uuid, force := s.OrgKeys.(*orgkeysfakes.FakeOrgKeyService).RemoveOrgKeyArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+			Expect(uuid).To(Equal("key-uuid"))
+			Expect(force).To(BeTrue())
+		})
+
+		It("Cleans up the organization's inactive clusters", func() {
+			report, err := o.CleanupInactiveClusters(clusters.CleanupOptions{InactiveDays: 7, DryRun: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.DryRun).To(BeTrue())
+			Expect(s.Clusters.(*clustersfakes.FakeClusterService).InactiveClustersArgsForCall(0)).To(Equal(orgID))
+		})
+
+		It("Registers clusters into the organization's groups", func() {
+			s.Clusters.(*clustersfakes.FakeClusterService).RegisterClusterReturns(&clusters.RegisterClusterResponseDataDetails{ClusterID: "new-cluster"}, nil)
+			_, err := o.RegisterClusterWithGroups(types.Registration{Name: "my_cluster"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			orgArg, _ := s.Clusters.(*clustersfakes.FakeClusterService).RegisterClusterArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+		})
+
+		It("Registers inventories into the organization", func() {
+			s.Clusters.(*clustersfakes.FakeClusterService).ClusterByNameReturns(nil, errors.New("Kablooie!"))
+			inventory := []clusters.InventoryEntry{{Registration: types.Registration{Name: "my_cluster"}}}
+			report, err := o.RegisterInventory(context.Background(), inventory, clusters.BulkRegistrationOptions{OutputDir: GinkgoT().TempDir()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Failed()).To(HaveLen(1))
+			orgArg, _ := s.Clusters.(*clustersfakes.FakeClusterService).ClusterByNameArgsForCall(0)
+			Expect(orgArg).To(Equal(orgID))
+		})
+
+		It("Is safe to use from multiple goroutines", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, _ = o.Channels.Channels()
+				}()
+			}
+			wg.Wait()
+			Expect(s.Channels.(*channelsfakes.FakeChannelService).ChannelsCallCount()).To(Equal(10))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Attributes are the free-form metadata a channel, group or subscription can
+// carry: a list of tags, and custom fields holding any JSON value.
+type Attributes struct {
+	Tags   []string               `json:"tags,omitempty"`
+	Custom map[string]interface{} `json:"custom,omitempty"`
+}
+
+// Validate checks that every tag is non-empty and that the custom fields can be
+// sent to SatCon.
+func (a Attributes) Validate() error {
+	for _, tag := range a.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return errors.New("Tags must not be empty")
+		}
+	}
+
+	if _, err := json.Marshal(a.Custom); err != nil {
+		return fmt.Errorf("Custom fields cannot be encoded: %s", err)
+	}
+
+	return nil
+}
+
+// HasTags reports whether every one of tags is present.  It is true if no tags
+// are given.
+func (a Attributes) HasTags(tags ...string) bool {
+	for _, want := range tags {
+		found := false
+		for _, tag := range a.Tags {
+			if tag == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Explicit returns a copy of the attributes with nil tags and custom fields replaced
+// by empty ones, so that sending it sets them to empty rather than null.
+func (a Attributes) Explicit() Attributes {
+	if a.Tags == nil {
+		a.Tags = []string{}
+	}
+	if a.Custom == nil {
+		a.Custom = map[string]interface{}{}
+	}
+	return a
+}
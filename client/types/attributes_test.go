@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("Attributes", func() {
+	var attrs Attributes
+
+	BeforeEach(func() {
+		attrs = Attributes{
+			Tags:   []string{"team-edge", "prod"},
+			Custom: map[string]interface{}{"owner": "edge@example.com", "tier": float64(1)},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("Accepts tags and custom fields", func() {
+			Expect(attrs.Validate()).To(Succeed())
+		})
+
+		It("Accepts no attributes", func() {
+			Expect(Attributes{}.Validate()).To(Succeed())
+		})
+
+		It("Rejects empty tags", func() {
+			attrs.Tags = append(attrs.Tags, " ")
+			Expect(attrs.Validate()).To(MatchError("Tags must not be empty"))
+		})
+
+		It("Rejects custom fields which cannot be encoded", func() {
+			attrs.Custom["callback"] = func() {}
+			Expect(attrs.Validate()).To(MatchError(HavePrefix("Custom fields cannot be encoded")))
+		})
+	})
+
+	Describe("HasTags", func() {
+		It("Is true when every tag is present", func() {
+			Expect(attrs.HasTags("prod", "team-edge")).To(BeTrue())
+		})
+
+		It("Is false when any tag is missing", func() {
+			Expect(attrs.HasTags("prod", "team-core")).To(BeFalse())
+		})
+
+		It("Is true when no tags are given", func() {
+			Expect(Attributes{}.HasTags()).To(BeTrue())
+		})
+	})
+
+	Describe("Explicit", func() {
+		It("Replaces missing tags and custom fields with empty ones", func() {
+			explicit := Attributes{}.Explicit()
+			Expect(explicit.Tags).To(Equal([]string{}))
+			Expect(explicit.Custom).To(Equal(map[string]interface{}{}))
+		})
+
+		It("Keeps tags and custom fields which are set", func() {
+			Expect(attrs.Explicit()).To(Equal(attrs))
+		})
+	})
+
+	It("Is decoded into the entities which carry it", func() {
+		var channel Channel
+		Expect(json.Unmarshal([]byte(`{"uuid":"c1","name":"chan","tags":["prod"],"custom":{"owner":"edge"}}`), &channel)).To(Succeed())
+		Expect(channel.Name).To(Equal("chan"))
+		Expect(channel.Tags).To(Equal([]string{"prod"}))
+		Expect(channel.Custom).To(Equal(map[string]interface{}{"owner": "edge"}))
+	})
+})
@@ -0,0 +1,31 @@
+package types
+
+// Distinct identifier types, so that e.g. an organization ID cannot silently be
+// passed where a cluster ID is expected.  The entity structs use them for their
+// identifiers, as does the OrgSatCon API.  The service clients still take plain
+// strings, so convert with e.g. string(id) or id.String() when calling them.
+
+// OrgID identifies an organization (an IBM Cloud account in Satellite Config).
+type OrgID string
+
+// ClusterID identifies a registered cluster.
+type ClusterID string
+
+// GroupUUID identifies a cluster group.
+type GroupUUID string
+
+// ChannelUUID identifies a channel (a configuration in Satellite Config).
+type ChannelUUID string
+
+// VersionUUID identifies a channel version.
+type VersionUUID string
+
+// SubscriptionUUID identifies a subscription.
+type SubscriptionUUID string
+
+func (id OrgID) String() string            { return string(id) }
+func (id ClusterID) String() string        { return string(id) }
+func (id GroupUUID) String() string        { return string(id) }
+func (id ChannelUUID) String() string      { return string(id) }
+func (id VersionUUID) String() string      { return string(id) }
+func (id SubscriptionUUID) String() string { return string(id) }
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("IDs", func() {
+	It("Decodes the identifiers of a cluster", func() {
+		var c Cluster
+		Expect(json.Unmarshal([]byte(`{"orgId":"someorg","clusterId":"somecluster","groups":[{"uuid":"g"}]}`), &c)).To(Succeed())
+		Expect(c.OrgID).To(Equal(OrgID("someorg")))
+		Expect(c.ClusterID).To(Equal(ClusterID("somecluster")))
+		Expect(c.ClusterID.String()).To(Equal("somecluster"))
+		Expect(c.Groups[0].UUID).To(Equal(GroupUUID("g")))
+	})
+
+	It("Decodes the identifiers of a subscription", func() {
+		var s Subscription
+		Expect(json.Unmarshal([]byte(`{"uuid":"sub","channelUuid":"chan","versionUuid":"ver"}`), &s)).To(Succeed())
+		Expect(s.UUID).To(Equal(SubscriptionUUID("sub")))
+		Expect(s.ChannelUUID).To(Equal(ChannelUUID("chan")))
+		Expect(s.VersionUUID).To(Equal(VersionUUID("ver")))
+	})
+
+	It("Encodes identifiers as plain strings", func() {
+		b, err := json.Marshal(DeployableVersion{UUID: "v", ChannelID: "c"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring(`"uuid":"v","channelId":"c"`))
+	})
+})
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// ClusterMetadata is the typed form of the metadata the Razee agent reports for a
+// cluster.  Retrieve it with Cluster.ParsedMetadata().
+type ClusterMetadata struct {
+	Name        string            `json:"name,omitempty"`
+	KubeVersion KubeVersion       `json:"kube_version,omitempty"`
+	Provider    string            `json:"provider,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// KubeVersion is the Kubernetes version information reported by the cluster.
+type KubeVersion struct {
+	Major      string `json:"major,omitempty"`
+	Minor      string `json:"minor,omitempty"`
+	GitVersion string `json:"gitVersion,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	BuildDate  string `json:"buildDate,omitempty"`
+}
+
+// ParsedMetadata decodes the untyped Metadata field into a ClusterMetadata.  It
+// returns nil if the cluster has no metadata.  Metadata may arrive either as a JSON
+// object or as a string containing one; both are handled.
+func (c Cluster) ParsedMetadata() (*ClusterMetadata, error) {
+	var raw []byte
+
+	switch m := c.Metadata.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if m == "" {
+			return nil, nil
+		}
+		raw = []byte(m)
+	case []byte:
+		raw = m
+	default:
+		var err error
+		raw, err = json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var metadata ClusterMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("ClusterMetadata", func() {
+	var (
+		rawMetadata string
+		expected    *ClusterMetadata
+	)
+
+	BeforeEach(func() {
+		rawMetadata = `{"name":"cluster1","kube_version":{"major":"1","minor":"26","gitVersion":"v1.26.3+IKS","platform":"linux/amd64"},"provider":"ibm","labels":{"env":"prod"}}`
+		expected = &ClusterMetadata{
+			Name: "cluster1",
+			KubeVersion: KubeVersion{
+				Major:      "1",
+				Minor:      "26",
+				GitVersion: "v1.26.3+IKS",
+				Platform:   "linux/amd64",
+			},
+			Provider: "ibm",
+			Labels:   map[string]string{"env": "prod"},
+		}
+	})
+
+	It("Parses metadata decoded from a JSON response", func() {
+		var c Cluster
+		Expect(json.Unmarshal([]byte(`{"metadata":`+rawMetadata+`}`), &c)).To(Succeed())
+		m, err := c.ParsedMetadata()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).To(Equal(expected))
+	})
+
+	It("Parses metadata supplied as a string", func() {
+		m, err := Cluster{Metadata: rawMetadata}.ParsedMetadata()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).To(Equal(expected))
+	})
+
+	It("Returns nil when there is no metadata", func() {
+		m, err := Cluster{}.ParsedMetadata()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).To(BeNil())
+	})
+
+	It("Errors when the metadata is not an object", func() {
+		_, err := Cluster{Metadata: "not json"}.ParsedMetadata()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Registration is the encapsulation of the JSON registration body of a cluster.
+// Name is required.  Razee stores the registration as arbitrary JSON, so fields
+// with no typed equivalent go in Extra, and are sent and returned alongside the
+// typed ones.
+type Registration struct {
+	Name     string            `json:"name,omitempty"`
+	Location string            `json:"location,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	// Extra holds any other registration fields, keyed by their JSON name
+	Extra map[string]interface{} `json:"-"`
+}
+
+// registration has the fields of Registration without its JSON methods
+type registration Registration
+
+// registrationFields are the JSON names of the typed Registration fields
+var registrationFields = []string{"name", "location", "labels"}
+
+// Validate checks the registration before it is sent to Satellite Config
+func (r Registration) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("Must supply a cluster name")
+	}
+
+	for key := range r.Labels {
+		if key == "" {
+			return errors.New("Label keys must not be empty")
+		}
+	}
+
+	for _, field := range registrationFields {
+		if _, ok := r.Extra[field]; ok {
+			return fmt.Errorf("Extra registration field %s must be set through its typed field", field)
+		}
+	}
+
+	for key, value := range r.Extra {
+		if key == "" {
+			return errors.New("Extra registration field names must not be empty")
+		}
+		if _, err := json.Marshal(value); err != nil {
+			return fmt.Errorf("Extra registration field %s is not valid JSON: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON merges Extra into the typed fields.  The typed fields take precedence.
+func (r Registration) MarshalJSON() ([]byte, error) {
+	typed, err := json.Marshal(registration(r))
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Extra) == 0 {
+		return typed, nil
+	}
+
+	fields := map[string]interface{}{}
+	for key, value := range r.Extra {
+		fields[key] = value
+	}
+	if err = json.Unmarshal(typed, &fields); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON fills in the typed fields, and collects any others in Extra.
+func (r *Registration) UnmarshalJSON(b []byte) error {
+	var typed registration
+	if err := json.Unmarshal(b, &typed); err != nil {
+		return err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	for _, field := range registrationFields {
+		delete(fields, field)
+	}
+	if len(fields) > 0 {
+		typed.Extra = fields
+	}
+
+	*r = Registration(typed)
+	return nil
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("Registration", func() {
+	var reg Registration
+
+	BeforeEach(func() {
+		reg = Registration{
+			Name:     "my_cluster",
+			Location: "us-east",
+			Labels:   map[string]string{"env": "prod"},
+			Extra: map[string]interface{}{
+				"rack":  float64(12),
+				"owner": map[string]interface{}{"team": "edge"},
+			},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("Accepts a complete registration", func() {
+			Expect(reg.Validate()).To(Succeed())
+		})
+
+		It("Requires a name", func() {
+			reg.Name = "  "
+			Expect(reg.Validate()).To(MatchError("Must supply a cluster name"))
+		})
+
+		It("Rejects empty label keys", func() {
+			reg.Labels[""] = "nope"
+			Expect(reg.Validate()).To(HaveOccurred())
+		})
+
+		It("Rejects extra fields which shadow typed fields", func() {
+			reg.Extra["location"] = "us-west"
+			Expect(reg.Validate()).To(MatchError(ContainSubstring("location")))
+		})
+
+		It("Rejects extra fields which cannot be serialized", func() {
+			reg.Extra["callback"] = func() {}
+			Expect(reg.Validate()).To(MatchError(ContainSubstring("callback")))
+		})
+	})
+
+	It("Marshals the extra fields alongside the typed ones", func() {
+		b, err := json.Marshal(reg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(MatchJSON(`{"name":"my_cluster","location":"us-east","labels":{"env":"prod"},"rack":12,"owner":{"team":"edge"}}`))
+	})
+
+	It("Marshals only the name of a plain registration", func() {
+		b, err := json.Marshal(Registration{Name: "my_cluster"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(MatchJSON(`{"name":"my_cluster"}`))
+	})
+
+	It("Round trips through JSON", func() {
+		b, err := json.Marshal(reg)
+		Expect(err).NotTo(HaveOccurred())
+
+		var decoded Registration
+		Expect(json.Unmarshal(b, &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(reg))
+	})
+
+	It("Leaves Extra nil when there are no other fields", func() {
+		var decoded Registration
+		Expect(json.Unmarshal([]byte(`{"name":"my_cluster"}`), &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(Registration{Name: "my_cluster"}))
+	})
+})
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Timestamp is a time.Time that (un)marshals the way Razee represents dates: an
+// RFC 3339 string, or a number of milliseconds since the epoch.  It always
+// marshals as an RFC 3339 string in UTC, and a zero Timestamp marshals as null.
+type Timestamp struct {
+	time.Time
+}
+
+// ParseTimestamp parses a Razee date string, which is either RFC 3339 or a
+// number of milliseconds since the epoch.  The empty string yields the zero time.
+func ParseTimestamp(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if millis, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(millis).UTC(), nil
+	}
+
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.UTC().Format(time.RFC3339Nano))
+}
+
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var s string
+	if len(b) > 0 && b[0] == '"' {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(b)
+	}
+
+	parsed, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+
+	t.Time = parsed
+	return nil
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/satcon-client-go/client/types"
+)
+
+var _ = Describe("Timestamp", func() {
+	var expected time.Time
+
+	BeforeEach(func() {
+		expected = time.Date(2021, time.March, 4, 5, 6, 7, 8000000, time.UTC)
+	})
+
+	Describe("ParseTimestamp", func() {
+		It("Parses RFC 3339 strings", func() {
+			t, err := ParseTimestamp("2021-03-04T05:06:07.008Z")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.Equal(expected)).To(BeTrue())
+		})
+
+		It("Parses milliseconds since the epoch", func() {
+			t, err := ParseTimestamp("1614834367008")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.Equal(expected)).To(BeTrue())
+		})
+
+		It("Returns the zero time for an empty string", func() {
+			t, err := ParseTimestamp("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.IsZero()).To(BeTrue())
+		})
+
+		It("Errors on garbage", func() {
+			_, err := ParseTimestamp("last tuesday")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("JSON", func() {
+		type holder struct {
+			When Timestamp `json:"when"`
+		}
+
+		It("Unmarshals strings and numbers", func() {
+			var h holder
+			Expect(json.Unmarshal([]byte(`{"when":"2021-03-04T05:06:07.008Z"}`), &h)).To(Succeed())
+			Expect(h.When.Equal(expected)).To(BeTrue())
+
+			Expect(json.Unmarshal([]byte(`{"when":1614834367008}`), &h)).To(Succeed())
+			Expect(h.When.Equal(expected)).To(BeTrue())
+
+			Expect(json.Unmarshal([]byte(`{"when":null}`), &h)).To(Succeed())
+			Expect(h.When.IsZero()).To(BeTrue())
+		})
+
+		It("Marshals as an RFC 3339 string", func() {
+			b, err := json.Marshal(holder{When: Timestamp{expected}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(`{"when":"2021-03-04T05:06:07.008Z"}`))
+
+			b, err = json.Marshal(holder{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(`{"when":null}`))
+		})
+	})
+
+	It("Decodes entity timestamps", func() {
+		var c Cluster
+		Expect(json.Unmarshal([]byte(`{"created":"2021-03-04T05:06:07.008Z","updated":1614834367008}`), &c)).To(Succeed())
+		Expect(c.Created.Equal(expected)).To(BeTrue())
+		Expect(c.Updated.Equal(expected)).To(BeTrue())
+	})
+})
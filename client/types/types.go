@@ -6,101 +6,135 @@ type BasicUser struct {
 }
 
 type BasicChannelSubscription struct {
-	UUID        string   `json:"uuid,omitempty"`
-	OrgID       string   `json:"orgId,omitempty"`
-	Name        string   `json:"name,omitempty"`
-	Groups      []string `json:"groups,omitempty"`
-	ChannelUUID string   `json:"channelUuid,omitempty"`
-	ChannelName string   `json:"channelName,omitempty"`
-	Version     string   `json:"version,omitempty"`
-	VersionUUID string   `json:"versionUuid,omitempty"`
-	Created     string   `json:"created,omitempty"`
-	Updated     string   `json:"updated,omitempty"`
+	UUID        SubscriptionUUID `json:"uuid,omitempty"`
+	OrgID       OrgID            `json:"orgId,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Groups      []string         `json:"groups,omitempty"`
+	ChannelUUID ChannelUUID      `json:"channelUuid,omitempty"`
+	ChannelName string           `json:"channelName,omitempty"`
+	Version     string           `json:"version,omitempty"`
+	VersionUUID VersionUUID      `json:"versionUuid,omitempty"`
+	Created     Timestamp        `json:"created,omitempty"`
+	Updated     Timestamp        `json:"updated,omitempty"`
 }
 
 // ChannelSubscription encapsulates a channel's subscription data
 type ChannelSubscription struct {
-	UUID        string     `json:"uuid,omitempty"`
-	OrgID       string     `json:"orgId,omitempty"`
-	Name        string     `json:"name,omitempty"`
-	Groups      []string   `json:"groups,omitempty"`
-	ChannelUUID string     `json:"channelUuid,omitempty"`
-	ChannelName string     `json:"channelName,omitempty"`
-	Channel     *Channel   `json:"channel,omitempty"`
-	Version     string     `json:"version,omitempty"`
-	VersionUUID string     `json:"versionUuid,omitempty"`
-	Owner       *BasicUser `json:"owner,omitempty"`
-	Created     string     `json:"created,omitempty"`
-	Updated     string     `json:"updated,omitempty"`
+	UUID        SubscriptionUUID `json:"uuid,omitempty"`
+	OrgID       OrgID            `json:"orgId,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Groups      []string         `json:"groups,omitempty"`
+	ChannelUUID ChannelUUID      `json:"channelUuid,omitempty"`
+	ChannelName string           `json:"channelName,omitempty"`
+	Channel     *Channel         `json:"channel,omitempty"`
+	Version     string           `json:"version,omitempty"`
+	VersionUUID VersionUUID      `json:"versionUuid,omitempty"`
+	Owner       *BasicUser       `json:"owner,omitempty"`
+	Created     Timestamp        `json:"created,omitempty"`
+	Updated     Timestamp        `json:"updated,omitempty"`
 }
 
 type Cluster struct {
-	ID        string `json:"id,omitempty"`
-	OrgID     string `json:"orgId,omitempty"`
-	ClusterID string `json:"clusterId,omitempty"`
-	Name      string `json:"name,omitempty"`
+	ID        string    `json:"id,omitempty"`
+	OrgID     OrgID     `json:"orgId,omitempty"`
+	ClusterID ClusterID `json:"clusterId,omitempty"`
+	Name      string    `json:"name,omitempty"`
 	// Metadata          []byte         `json:"metadata,omitempty"`
 	Metadata          interface{}    `json:"metadata,omitempty"`
 	Comments          []Comment      `json:"comments,omitempty"`
 	Registration      Registration   `json:"registration,omitempty"`
 	RegistrationState string         `json:"regState,omitempty"`
 	Groups            []ClusterGroup `json:"groups,omitempty"`
-	Created           string         `json:"created,omitempty"`
-	Updated           string         `json:"updated,omitempty"`
+	Created           Timestamp      `json:"created,omitempty"`
+	Updated           Timestamp      `json:"updated,omitempty"`
 	Dirty             bool           `json:"dirty,omitempty"`
 }
 
+// ClusterCountByKubeVersion is the number of clusters running a Kubernetes version.
+// Only the Major and Minor fields of ID are set.
+type ClusterCountByKubeVersion struct {
+	ID    KubeVersion `json:"id,omitempty"`
+	Count int         `json:"count,omitempty"`
+}
+
 type ClusterInfo struct {
-	ClusterID string `json:"clusterId,omitempty"`
-	Name      string `json:"name,omitempty"`
+	ClusterID ClusterID `json:"clusterId,omitempty"`
+	Name      string    `json:"name,omitempty"`
 }
 
 type ClusterList []Cluster
 
 type ClusterGroup struct {
-	UUID string `json:"uuid,omitempty"`
-	Name string `json:"name,omitempty"`
+	UUID GroupUUID `json:"uuid,omitempty"`
+	Name string    `json:"name,omitempty"`
 }
 
 type ClusterGroupList []ClusterGroup
 
 type Channel struct {
-	UUID          string                `json:"uuid,omitempty"`
-	OrgID         string                `json:"orgId,omitempty"`
-	Name          string                `json:"name,omitempty"`
-	Created       string                `json:"created,omitempty"`
+	UUID  ChannelUUID `json:"uuid,omitempty"`
+	OrgID OrgID       `json:"orgId,omitempty"`
+	Name  string      `json:"name,omitempty"`
+	// ContentType is "upload" for channels whose versions are uploaded YAML, or
+	// "remote" for channels whose versions reference content held elsewhere
+	ContentType   string                `json:"contentType,omitempty"`
+	Remote        *ChannelRemote        `json:"remote,omitempty"`
+	Created       Timestamp             `json:"created,omitempty"`
 	Versions      []ChannelVersion      `json:"versions,omitempty"`
 	Subscriptions []ChannelSubscription `json:"subscriptions,omitempty"`
+	Attributes
+}
+
+// ChannelRemote describes where the content of a remote channel's versions is
+// held, e.g. a remoteType of "github" with the repository as a parameter
+type ChannelRemote struct {
+	RemoteType string           `json:"remoteType,omitempty"`
+	Parameters []ParameterTuple `json:"parameters,omitempty"`
+}
+
+// VersionRemote holds the parameters locating the content of a remote channel
+// version, such as the git ref or file path within the channel's remote
+type VersionRemote struct {
+	Parameters []ParameterTuple `json:"parameters,omitempty"`
+}
+
+// ParameterTuple is a single key/value parameter of a remote
+type ParameterTuple struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
 type ChannelList []Channel
 
 type ChannelVersion struct {
-	UUID        string `json:"uuid,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Location    string `json:"location,omitempty"`
-	Created     string `json:"created,omitempty"`
+	UUID        VersionUUID    `json:"uuid,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Location    string         `json:"location,omitempty"`
+	Remote      *VersionRemote `json:"remote,omitempty"`
+	Created     Timestamp      `json:"created,omitempty"`
 }
 
 type ChannelVersionList []ChannelVersion
 
 type Comment struct {
-	UserId  string `json:"user_id,omitempty"`
-	Content string `json:"content,omitempty"`
-	Created string `json:"created,omitempty"`
+	UserId  string    `json:"user_id,omitempty"`
+	Content string    `json:"content,omitempty"`
+	Created Timestamp `json:"created,omitempty"`
 }
 
 type DeployableVersion struct {
-	OrgID       string `json:"orgId,omitempty"`
-	UUID        string `json:"uuid,omitempty"`
-	ChannelID   string `json:"channelId,omitempty"`
-	ChannelName string `json:"channelName,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Type        string `json:"type,omitempty"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	Created     string `json:"created,omitempty"`
+	OrgID       OrgID       `json:"orgId,omitempty"`
+	UUID        VersionUUID `json:"uuid,omitempty"`
+	ChannelID   ChannelUUID `json:"channelId,omitempty"`
+	ChannelName string      `json:"channelName,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Type        string      `json:"type,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Content     string      `json:"content,omitempty"`
+	// Remote is set instead of Content for versions of remote channels
+	Remote  *VersionRemote `json:"remote,omitempty"`
+	Created Timestamp      `json:"created,omitempty"`
 }
 
 type RequestError struct {
@@ -108,39 +142,40 @@ type RequestError struct {
 }
 
 type RequestErrorDetails struct {
-	Message string `json:"message,omitempty"`
+	Message    string                  `json:"message,omitempty"`
+	Extensions *RequestErrorExtensions `json:"extensions,omitempty"`
+}
+
+// RequestErrorExtensions carries the machine-readable details of a GraphQL error.
+type RequestErrorExtensions struct {
+	Code string `json:"code,omitempty"`
 }
 
 type GroupList []Group
 
 type Group struct {
-	UUID     string    `json:"uuid,omitempty"`
-	OrgID    string    `json:"orgId,omitempty"`
+	UUID     GroupUUID `json:"uuid,omitempty"`
+	OrgID    OrgID     `json:"orgId,omitempty"`
 	Name     string    `json:"name,omitempty"`
 	Owner    BasicUser `json:"owner,omitempty"`
-	Created  string    `json:"created,omitempty"`
+	Created  Timestamp `json:"created,omitempty"`
 	Clusters []Cluster `json:"clusters,omitempty"`
-}
-
-// Registration is the encapsulation of the JSON registration body, which at this
-// point is used primarily to specify the name of the cluster to register.
-type Registration struct {
-	Name string `json:"name,omitempty"`
+	Attributes
 }
 
 // Resource encapsulates satellite cluster resources
 type Resource struct {
 	ID                 string              `json:"id,omitempty"`
-	OrgID              string              `json:"orgId,omitempty"`
-	ClusterID          string              `json:"clusterId,omitempty"`
+	OrgID              OrgID               `json:"orgId,omitempty"`
+	ClusterID          ClusterID           `json:"clusterId,omitempty"`
 	Cluster            ClusterInfo         `json:"cluster,omitempty"`
 	HistId             string              `json:"histId,omitempty"`
 	SelfLink           string              `json:"selfLink,omitempty"`
 	Hash               string              `json:"hash,omitempty"`
 	Data               string              `json:"data,omitempty"`
 	Deleted            bool                `json:"deleted,omitempty"`
-	Created            string              `json:"created,omitempty"`
-	Updated            string              `json:"updated,omitempty"`
+	Created            Timestamp           `json:"created,omitempty"`
+	Updated            Timestamp           `json:"updated,omitempty"`
 	LastModified       string              `json:"lastModified,omitempty"`
 	SearchableData     SearchableData      `json:"searchableData,omitempty"`
 	SearchableDataHash string              `json:"searchableDataHash,omitempty"`
@@ -154,10 +189,10 @@ type ResourceList struct {
 }
 
 type ResourceContentObj struct {
-	ID      string `json:"id,omitempty"`
-	HistID  string `json:"histId,omitempty"`
-	Content string `json:"content,omitempty"`
-	Updated string `json:"updated,omitempty"`
+	ID      string    `json:"id,omitempty"`
+	HistID  string    `json:"histId,omitempty"`
+	Content string    `json:"content,omitempty"`
+	Updated Timestamp `json:"updated,omitempty"`
 }
 
 // SearchableData encapsulates cluster resource data
@@ -173,23 +208,34 @@ type SearchableData struct {
 
 // Subscription encapsulates satellite subscription data
 type Subscription struct {
-	UUID        string    `json:"uuid,omitempty"`
-	OrgID       string    `json:"orgId,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Groups      []string  `json:"groups,omitempty"`
-	ChannelUUID string    `json:"channelUuid,omitempty"`
-	ChannelName string    `json:"channelName,omitempty"`
-	Channel     Channel   `json:"channel,omitempty"`
-	Version     string    `json:"version,omitempty"`
-	VersionUUID string    `json:"versionUuid,omitempty"`
-	Owner       BasicUser `json:"owner,omitempty"`
-	Created     string    `json:"created,omitempty"`
-	Updated     string    `json:"updated,omitempty"`
+	UUID        SubscriptionUUID `json:"uuid,omitempty"`
+	OrgID       OrgID            `json:"orgId,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Groups      []string         `json:"groups,omitempty"`
+	ChannelUUID ChannelUUID      `json:"channelUuid,omitempty"`
+	ChannelName string           `json:"channelName,omitempty"`
+	Channel     Channel          `json:"channel,omitempty"`
+	Version     string           `json:"version,omitempty"`
+	VersionUUID VersionUUID      `json:"versionUuid,omitempty"`
+	Owner       BasicUser        `json:"owner,omitempty"`
+	Created     Timestamp        `json:"created,omitempty"`
+	Updated     Timestamp        `json:"updated,omitempty"`
+	Attributes
 }
 
 // SubscriptionList list of subscriptions
 type SubscriptionList []Subscription
 
+// UpdatedSubscription is a subscription as seen by the Razee agent of a cluster it targets
+type UpdatedSubscription struct {
+	SubscriptionName    string           `json:"subscriptionName,omitempty"`
+	SubscriptionChannel string           `json:"subscriptionChannel,omitempty"`
+	SubscriptionVersion string           `json:"subscriptionVersion,omitempty"`
+	SubscriptionUUID    SubscriptionUUID `json:"subscriptionUuid,omitempty"`
+	URL                 string           `json:"url,omitempty"`
+	KubeOwnerName       string           `json:"kubeOwnerName,omitempty"`
+}
+
 type Token string
 
 type User struct {
@@ -201,6 +247,25 @@ type User struct {
 	Role       string `json:"role,omitempty"`
 }
 
+// OrgKey is an API key Razee agents use to authenticate on behalf of an organization.
+// Listing org keys does not return the keys themselves.
+type OrgKey struct {
+	UUID    string    `json:"uuid,omitempty"`
+	Name    string    `json:"name,omitempty"`
+	Primary bool      `json:"primary"`
+	Created Timestamp `json:"created,omitempty"`
+	Updated Timestamp `json:"updated,omitempty"`
+	Key     string    `json:"key,omitempty"`
+}
+
+type OrgKeyList []OrgKey
+
+// BasicOrganization identifies an organization a Razee user belongs to
+type BasicOrganization struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
 type UuidOnly struct {
 	UUID string `json:"uuid,omitempty"`
 }
@@ -1,8 +1,10 @@
 package web
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -19,15 +21,37 @@ type HTTPClient interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
+// UnauthenticatedErrorCode is the GraphQL error code returned when the server
+// rejects the request's credentials.
+const UnauthenticatedErrorCode = "UNAUTHENTICATED"
+
+// NotFoundErrorCode is the GraphQL error code returned when the requested entity
+// does not exist, e.g. by lookups such as clusterByName.
+const NotFoundErrorCode = "NOT_FOUND"
+
 //SatConClient struct to create HTTPClient and IAMClient interfaces
 type SatConClient struct {
 	Endpoint   string
 	HTTPClient HTTPClient
 	AuthClient auth.AuthClient
+	// EndpointPool, if set, takes precedence over Endpoint and enables
+	// failover between regional endpoints.
+	EndpointPool *EndpointPool
+}
+
+// UseEndpointPool makes the client send its requests to the endpoints in the pool.
+func (s *SatConClient) UseEndpointPool(pool *EndpointPool) {
+	s.EndpointPool = pool
+	if pool != nil {
+		s.Endpoint = pool.Primary()
+	}
 }
 
 // DoQuery makes the graphql query request and returns the result
 func (s *SatConClient) DoQuery(requestTemplate string, vars interface{}, funcs template.FuncMap, result interface{}) error {
+	if s.EndpointPool != nil {
+		return DoQueryWithFailover(s.HTTPClient, s.EndpointPool, s.AuthClient, requestTemplate, vars, funcs, result)
+	}
 	return DoQuery(s.HTTPClient, s.Endpoint, s.AuthClient, requestTemplate, vars, funcs, result)
 }
 
@@ -39,40 +63,189 @@ func DoQuery(httpClient HTTPClient,
 	vars interface{},
 	funcs template.FuncMap,
 	result interface{}) error {
-	payload, err := actions.BuildRequestBody(requestTemplate, vars, funcs)
+	payload, err := bufferRequestBody(requestTemplate, vars, funcs)
 	if err != nil {
 		return err
 	}
 
-	req, err := actions.BuildRequest(payload, endpoint, authClient)
+	_, body, err := send(httpClient, endpoint, authClient, payload)
 	if err != nil {
-		return err
+		return unwrapBuildRequestError(err)
 	}
 
-	response, err := httpClient.Do(req)
+	return decodeResponse(body, result)
+}
+
+// DoQueryWithFailover makes the graphql query request against the endpoints in the
+// pool and returns the result.  Each candidate endpoint is tried in turn until one
+// responds without a connection error or 5xx status; see EndpointPool.Candidates
+// for the order and for how mutations are handled.
+func DoQueryWithFailover(httpClient HTTPClient,
+	pool *EndpointPool,
+	authClient auth.AuthClient,
+	requestTemplate string,
+	vars interface{},
+	funcs template.FuncMap,
+	result interface{}) error {
+	// The payload is replayed against every candidate, so it has to be buffered
+	payload, err := bufferRequestBody(requestTemplate, vars, funcs)
 	if err != nil {
 		return err
 	}
 
-	if response.Body != nil {
-		defer response.Body.Close()
-		body, err := ioutil.ReadAll(response.Body)
+	var lastErr error
+	for _, endpoint := range pool.Candidates(operationType(vars)) {
+		response, body, err := send(httpClient, endpoint, authClient, payload)
+		if err == nil && response.StatusCode >= http.StatusInternalServerError {
+			err = &EndpointError{Endpoint: endpoint, StatusCode: response.StatusCode}
+		}
 		if err != nil {
-			return err
+			if _, ok := err.(buildRequestError); ok {
+				return unwrapBuildRequestError(err)
+			}
+			pool.MarkUnhealthy(endpoint)
+			lastErr = err
+			continue
 		}
 
-		if err = CheckResponseForErrors(body); err != nil {
-			return err
-		}
+		pool.MarkHealthy(endpoint)
+		return decodeResponse(body, result)
+	}
 
-		err = json.Unmarshal(body, result)
+	return lastErr
+}
 
-		if err != nil {
-			return err
+// EndpointError is returned when an endpoint responds with a server error.
+type EndpointError struct {
+	Endpoint   string
+	StatusCode int
+}
+
+func (e *EndpointError) Error() string {
+	return fmt.Sprintf("Endpoint %s responded with status %d", e.Endpoint, e.StatusCode)
+}
+
+// operationType returns the GraphQL operation type of the query variables.  Anything
+// that cannot be identified is treated as a mutation so it will not be replayed.
+func operationType(vars interface{}) actions.QueryType {
+	if op, ok := vars.(interface{ OperationType() actions.QueryType }); ok {
+		return op.OperationType()
+	}
+	return actions.QueryTypeMutation
+}
+
+// bufferRequestBody builds the request payload and reads it into memory so the
+// request can be sent more than once.
+func bufferRequestBody(requestTemplate string, vars interface{}, funcs template.FuncMap) ([]byte, error) {
+	payload, err := actions.BuildRequestBody(requestTemplate, vars, funcs)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(payload)
+}
+
+// buildRequestError wraps errors which occur while building or authenticating a
+// request, as opposed to errors returned by the endpoint.
+type buildRequestError struct {
+	error
+}
+
+func unwrapBuildRequestError(err error) error {
+	if be, ok := err.(buildRequestError); ok {
+		return be.error
+	}
+	return err
+}
+
+// send posts the payload to the endpoint and returns the response along with its
+// body, which has already been read and closed.  If the server rejects the
+// credentials, either with a 401 or with an UNAUTHENTICATED GraphQL error, and the
+// auth client is an auth.RefreshableAuthClient, the cached credentials are
+// invalidated and the request is sent once more with fresh ones.
+func send(httpClient HTTPClient, endpoint string, authClient auth.AuthClient, payload []byte) (*http.Response, []byte, error) {
+	response, body, err := roundTrip(httpClient, endpoint, authClient, payload)
+	if err != nil || !isUnauthenticated(response, body) {
+		return response, body, err
+	}
+
+	refreshable, ok := authClient.(auth.RefreshableAuthClient)
+	if !ok {
+		return response, body, nil
+	}
+
+	refreshable.Invalidate()
+	return roundTrip(httpClient, endpoint, authClient, payload)
+}
+
+func roundTrip(httpClient HTTPClient, endpoint string, authClient auth.AuthClient, payload []byte) (*http.Response, []byte, error) {
+	req, err := actions.BuildRequest(bytes.NewReader(payload), endpoint, authClient)
+	if err != nil {
+		return nil, nil, buildRequestError{err}
+	}
+
+	response, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if response.Body == nil {
+		return response, nil, nil
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, body, nil
+}
+
+// isUnauthenticated reports whether the server rejected the request's credentials.
+func isUnauthenticated(response *http.Response, body []byte) bool {
+	if response.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+
+	if !strings.Contains(string(body), UnauthenticatedErrorCode) {
+		return false
+	}
+
+	var errorDetails types.RequestError
+	if json.Unmarshal(body, &errorDetails) != nil {
+		return false
+	}
+
+	for _, e := range errorDetails.Errors {
+		if e.Extensions != nil && e.Extensions.Code == UnauthenticatedErrorCode {
+			return true
 		}
 	}
+	return false
+}
 
-	return nil
+// isNotFound reports whether the GraphQL errors all report a missing entity.
+func isNotFound(errs []types.RequestErrorDetails) bool {
+	for _, e := range errs {
+		if e.Extensions == nil || e.Extensions.Code != NotFoundErrorCode {
+			return false
+		}
+	}
+	return len(errs) > 0
+}
+
+// decodeResponse checks the response body for GraphQL errors and deserializes it into result.
+func decodeResponse(body []byte, result interface{}) error {
+	if body == nil {
+		return nil
+	}
+
+	if err := CheckResponseForErrors(body); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, result)
 }
 
 /*
@@ -81,7 +254,8 @@ func DoQuery(httpClient HTTPClient,
  * 200OK, even if the request is "bad". For example the, attempting to access resources using an
  * orgID that is not accessible via the token will still return a 200OK, but will contain an error
  * message in the body. This function will parse that error message and return to user to provide
- * better information about the request and better error handling.
+ * better information about the request and better error handling.  If every error has the
+ * NOT_FOUND code, the error returned is an *ErrNotFound.
  */
 func CheckResponseForErrors(body []byte) error {
 	if strings.Contains(string(body), "errors") {
@@ -100,6 +274,9 @@ func CheckResponseForErrors(body []byte) error {
 					errorMessage += ", "
 				}
 			}
+			if isNotFound(errorDetails.Errors) {
+				return &ErrNotFound{Message: errorMessage}
+			}
 			return errors.New(errorMessage)
 		}
 	}
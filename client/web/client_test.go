@@ -184,6 +184,228 @@ var _ = Describe("Client", func() {
 
 		})
 
+		Describe("DoQuery with an EndpointPool", func() {
+			type QueryVars struct {
+				actions.GraphQLQuery
+				Name string
+			}
+
+			var (
+				requestTemplate string
+				vars            QueryVars
+				pool            *EndpointPool
+				result          QueryResponse
+				okBody          []byte
+			)
+
+			BeforeEach(func() {
+				var err error
+				pool, err = NewEndpointPool("https://primary", "https://secondary")
+				Expect(err).NotTo(HaveOccurred())
+				s.UseEndpointPool(pool)
+
+				okBody, _ = json.Marshal(QueryResponse{Name: "george"})
+				requestTemplate = `{{define "vars"}}"name":{{json .Name}}{{end}}`
+				vars = QueryVars{Name: "foo"}
+				vars.Type = actions.QueryTypeQuery
+				vars.QueryName = "SomeQuery"
+				vars.Args = map[string]string{"name": "String!"}
+				vars.Returns = []string{"name"}
+
+				fakeAuthClient.AuthenticateStub = nil
+			})
+
+			It("Uses the primary endpoint", func() {
+				h.DoReturns(&http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(okBody))}, nil)
+				err := s.DoQuery(requestTemplate, vars, nil, &result)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Name).To(Equal("george"))
+				Expect(h.DoCallCount()).To(Equal(1))
+				Expect(h.DoArgsForCall(0).URL.String()).To(Equal("https://primary"))
+			})
+
+			Context("When the primary returns a server error", func() {
+				BeforeEach(func() {
+					h.DoReturnsOnCall(0, &http.Response{StatusCode: http.StatusBadGateway, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil)
+					h.DoReturnsOnCall(1, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(okBody))}, nil)
+				})
+
+				It("Fails a query over to the next endpoint", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.Name).To(Equal("george"))
+					Expect(h.DoCallCount()).To(Equal(2))
+					Expect(h.DoArgsForCall(1).URL.String()).To(Equal("https://secondary"))
+
+					body, _ := ioutil.ReadAll(h.DoArgsForCall(1).Body)
+					Expect(string(body)).To(ContainSubstring(`"name":"foo"`))
+				})
+
+				It("Marks the primary unhealthy", func() {
+					_ = s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(pool.Healthy("https://primary")).To(BeFalse())
+					Expect(pool.Healthy("https://secondary")).To(BeTrue())
+				})
+
+				It("Does not fail a mutation over", func() {
+					vars.Type = actions.QueryTypeMutation
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).To(BeAssignableToTypeOf(&EndpointError{}))
+					Expect(err.(*EndpointError).StatusCode).To(Equal(http.StatusBadGateway))
+					Expect(h.DoCallCount()).To(Equal(1))
+				})
+
+				It("Fails a mutation over when allowed", func() {
+					vars.Type = actions.QueryTypeMutation
+					pool.AllowMutationFailover = true
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(h.DoCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("When every endpoint has a connection error", func() {
+				BeforeEach(func() {
+					h.DoReturns(nil, &url.Error{Op: "Post", Err: errors.New("connection refused")})
+				})
+
+				It("Returns the last error", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					_, ok := err.(*url.Error)
+					Expect(ok).To(BeTrue())
+					Expect(h.DoCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("When the response contains GraphQL errors", func() {
+				BeforeEach(func() {
+					h.DoReturns(&http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewBufferString(`{"errors": [{"message": "nope"}]}`))}, nil)
+				})
+
+				It("Returns the error without failing over", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).To(MatchError("nope"))
+					Expect(h.DoCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Describe("DoQuery when the credentials are rejected", func() {
+			type QueryVars struct {
+				actions.GraphQLQuery
+				Name string
+			}
+
+			var (
+				requestTemplate       string
+				vars                  QueryVars
+				result                QueryResponse
+				okBody                []byte
+				unauthenticated       []byte
+				refreshableAuthClient *authfakes.FakeRefreshableAuthClient
+			)
+
+			BeforeEach(func() {
+				refreshableAuthClient = &authfakes.FakeRefreshableAuthClient{}
+				s.AuthClient = refreshableAuthClient
+
+				okBody, _ = json.Marshal(QueryResponse{Name: "george"})
+				unauthenticated = []byte(`{"errors": [{"message": "Context creation failed: Your session expired. Sign in again","extensions": {"code": "UNAUTHENTICATED"}}]}`)
+				requestTemplate = `{{define "vars"}}"name":{{json .Name}}{{end}}`
+				vars = QueryVars{Name: "foo"}
+				vars.Type = actions.QueryTypeMutation
+				vars.QueryName = "SomeQuery"
+				vars.Args = map[string]string{"name": "String!"}
+				vars.Returns = []string{"name"}
+			})
+
+			Context("With a 401 status", func() {
+				BeforeEach(func() {
+					h.DoReturnsOnCall(0, &http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil)
+					h.DoReturnsOnCall(1, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(okBody))}, nil)
+				})
+
+				It("Invalidates the credentials and replays the request", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.Name).To(Equal("george"))
+					Expect(refreshableAuthClient.InvalidateCallCount()).To(Equal(1))
+					Expect(refreshableAuthClient.AuthenticateCallCount()).To(Equal(2))
+					Expect(h.DoCallCount()).To(Equal(2))
+
+					body, _ := ioutil.ReadAll(h.DoArgsForCall(1).Body)
+					Expect(string(body)).To(ContainSubstring(`"name":"foo"`))
+				})
+			})
+
+			Context("With an UNAUTHENTICATED error", func() {
+				BeforeEach(func() {
+					h.DoReturnsOnCall(0, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(unauthenticated))}, nil)
+					h.DoReturnsOnCall(1, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(okBody))}, nil)
+				})
+
+				It("Invalidates the credentials and replays the request", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.Name).To(Equal("george"))
+					Expect(refreshableAuthClient.InvalidateCallCount()).To(Equal(1))
+					Expect(h.DoCallCount()).To(Equal(2))
+				})
+
+				It("Replays the request through an EndpointPool", func() {
+					pool, err := NewEndpointPool("https://primary", "https://secondary")
+					Expect(err).NotTo(HaveOccurred())
+					s.UseEndpointPool(pool)
+
+					err = s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(h.DoCallCount()).To(Equal(2))
+					Expect(h.DoArgsForCall(1).URL.String()).To(Equal("https://primary"))
+				})
+			})
+
+			Context("When the replayed request is rejected too", func() {
+				BeforeEach(func() {
+					h.DoReturnsOnCall(0, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(unauthenticated))}, nil)
+					h.DoReturnsOnCall(1, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(unauthenticated))}, nil)
+				})
+
+				It("Returns the error after a single retry", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).To(MatchError("Context creation failed: Your session expired. Sign in again"))
+					Expect(refreshableAuthClient.InvalidateCallCount()).To(Equal(1))
+					Expect(h.DoCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("When the auth client cannot be refreshed", func() {
+				BeforeEach(func() {
+					s.AuthClient = &fakeAuthClient
+					fakeAuthClient.AuthenticateStub = nil
+					h.DoReturns(&http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(bytes.NewReader(unauthenticated))}, nil)
+				})
+
+				It("Does not replay the request", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).To(HaveOccurred())
+					Expect(h.DoCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("When the request is rejected for another reason", func() {
+				BeforeEach(func() {
+					h.DoReturns(&http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewBufferString(`{"errors": [{"message": "nope","extensions": {"code": "FORBIDDEN"}}]}`))}, nil)
+				})
+
+				It("Does not replay the request", func() {
+					err := s.DoQuery(requestTemplate, vars, nil, &result)
+					Expect(err).To(MatchError("nope"))
+					Expect(refreshableAuthClient.InvalidateCallCount()).To(Equal(0))
+					Expect(h.DoCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Describe("CheckResponseForErrors", func() {
 			var errorResponse *types.RequestError
 			var badBytes []byte
@@ -223,6 +445,22 @@ var _ = Describe("Client", func() {
 				Expect(err.Error()).To(MatchRegexp("unexpected end of JSON input"))
 			})
 
+			Context("When the server reports the entity as not found", func() {
+				It("Returns an ErrNotFound carrying the server's message", func() {
+					body := []byte(`{"errors":[{"message":"Could not find the cluster with name nope.","extensions":{"code":"NOT_FOUND"}}],"data":null}`)
+					err := CheckResponseForErrors(body)
+					Expect(err).To(BeAssignableToTypeOf(&ErrNotFound{}))
+					Expect(err).To(MatchError("Could not find the cluster with name nope."))
+				})
+
+				It("Returns a plain error if any other error is reported alongside", func() {
+					body := []byte(`{"errors":[{"message":"Not here","extensions":{"code":"NOT_FOUND"}},{"message":"Broken","extensions":{"code":"INTERNAL_SERVER_ERROR"}}]}`)
+					err := CheckResponseForErrors(body)
+					Expect(err).To(MatchError("Not here, Broken"))
+					Expect(err).NotTo(BeAssignableToTypeOf(&ErrNotFound{}))
+				})
+			})
+
 			Context("When there are multiple error messages from request", func() {
 				var bytes []byte
 				BeforeEach(func() {
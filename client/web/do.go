@@ -0,0 +1,77 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+)
+
+// ErrEmptyResponse is returned by Do when the response carries no "data" at all,
+// e.g. an empty body.
+type ErrEmptyResponse struct {
+	Field string
+}
+
+func (e *ErrEmptyResponse) Error() string {
+	return fmt.Sprintf("Empty response received for %s", e.Field)
+}
+
+// ErrNotFound is returned by Do when the requested entity does not exist.  Razee
+// reports this for lookups such as clusterByName as a GraphQL error with the
+// NOT_FOUND code, in which case Message holds the server's message.  It is also
+// returned when the response carries "data", but the requested field is missing
+// or null.
+type ErrNotFound struct {
+	Field string
+	// Message is the server's error message, if it reported the entity as not found
+	Message string
+}
+
+func (e *ErrNotFound) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("No %s found", e.Field)
+}
+
+// Do makes the graphql query request and decodes the named field of the
+// response's "data" object into a T, so that operations don't each need to
+// declare their own response envelope.  The field is almost always the
+// QueryName of the supplied variables.
+//
+// Callers can rely on errors.As with an *ErrNotFound to tell a missing entity
+// apart from other failures: a NOT_FOUND GraphQL error, or a null or missing
+// field, is always returned as an *ErrNotFound naming the field.
+func Do[T any](c *SatConClient, field string, requestTemplate string, vars interface{}, funcs template.FuncMap) (T, error) {
+	var (
+		response struct {
+			Data map[string]json.RawMessage `json:"data,omitempty"`
+		}
+		result T
+	)
+
+	err := c.DoQuery(requestTemplate, vars, funcs, &response)
+	if err != nil {
+		var notFound *ErrNotFound
+		if errors.As(err, &notFound) && notFound.Field == "" {
+			notFound.Field = field
+		}
+		return result, err
+	}
+
+	if response.Data == nil {
+		return result, &ErrEmptyResponse{Field: field}
+	}
+
+	raw, ok := response.Data[field]
+	if !ok || string(raw) == "null" {
+		return result, &ErrNotFound{Field: field}
+	}
+
+	if err = json.Unmarshal(raw, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
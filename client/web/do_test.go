@@ -0,0 +1,111 @@
+package web_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/web"
+	"github.com/IBM/satcon-client-go/client/web/webfakes"
+)
+
+var _ = Describe("Do", func() {
+	type Thing struct {
+		Name string `json:"name"`
+	}
+
+	type QueryVars struct {
+		actions.GraphQLQuery
+		Name string
+	}
+
+	var (
+		s               *SatConClient
+		h               *webfakes.FakeHTTPClient
+		requestTemplate string
+		vars            QueryVars
+	)
+
+	respondWith := func(body string) {
+		h.DoReturns(&http.Response{Body: ioutil.NopCloser(bytes.NewBufferString(body))}, nil)
+	}
+
+	BeforeEach(func() {
+		h = &webfakes.FakeHTTPClient{}
+		s = &SatConClient{
+			Endpoint:   "https://foo.bar",
+			HTTPClient: h,
+		}
+
+		requestTemplate = `{{define "vars"}}"name":{{json .Name}}{{end}}`
+		vars = QueryVars{Name: "foo"}
+		vars.Type = actions.QueryTypeQuery
+		vars.QueryName = "thing"
+		vars.Args = map[string]string{"name": "String!"}
+		vars.Returns = []string{"name"}
+	})
+
+	It("Decodes the named field", func() {
+		respondWith(`{"data":{"thing":{"name":"george"}}}`)
+		thing, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(thing).To(Equal(&Thing{Name: "george"}))
+	})
+
+	It("Decodes lists", func() {
+		respondWith(`{"data":{"thing":[{"name":"george"},{"name":"frank"}]}}`)
+		things, err := Do[[]Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(things).To(Equal([]Thing{{Name: "george"}, {Name: "frank"}}))
+	})
+
+	It("Returns ErrEmptyResponse when there is no data", func() {
+		respondWith(`{}`)
+		thing, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(MatchError(&ErrEmptyResponse{Field: "thing"}))
+		Expect(thing).To(BeNil())
+	})
+
+	It("Returns ErrEmptyResponse when there is no body", func() {
+		h.DoReturns(&http.Response{}, nil)
+		_, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(BeAssignableToTypeOf(&ErrEmptyResponse{}))
+	})
+
+	It("Returns ErrNotFound when the field is null", func() {
+		respondWith(`{"data":{"thing":null}}`)
+		thing, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(MatchError(&ErrNotFound{Field: "thing"}))
+		Expect(thing).To(BeNil())
+	})
+
+	It("Returns ErrNotFound when the field is missing", func() {
+		respondWith(`{"data":{"other":{}}}`)
+		_, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(BeAssignableToTypeOf(&ErrNotFound{}))
+	})
+
+	It("Returns ErrNotFound when the server reports a NOT_FOUND error", func() {
+		respondWith(`{"errors":[{"message":"Could not find the thing.","extensions":{"code":"NOT_FOUND"}}],"data":null}`)
+		thing, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(MatchError(&ErrNotFound{Field: "thing", Message: "Could not find the thing."}))
+		Expect(thing).To(BeNil())
+	})
+
+	It("Bubbles up query errors", func() {
+		h.DoReturns(nil, errors.New("Kaboom"))
+		_, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(MatchError("Kaboom"))
+	})
+
+	It("Bubbles up decoding errors", func() {
+		respondWith(`{"data":{"thing":"not an object"}}`)
+		_, err := Do[*Thing](s, "thing", requestTemplate, vars, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,115 @@
+package web
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+)
+
+// DefaultUnhealthyCooldown is how long an endpoint is skipped after a failed
+// request before it is considered healthy again.
+const DefaultUnhealthyCooldown = 30 * time.Second
+
+// EndpointPool is an ordered list of SatCon endpoints, the first of which is
+// the primary.  Read queries fail over to the next endpoint on connection errors
+// or 5xx responses.  Mutations are pinned to the primary unless AllowMutationFailover
+// is set.  An EndpointPool is safe for use by multiple goroutines.
+type EndpointPool struct {
+	// AllowMutationFailover lets mutations be retried against secondary endpoints.
+	// Only enable this if the endpoints share a backing store, otherwise a
+	// mutation could be applied twice.
+	AllowMutationFailover bool
+	// UnhealthyCooldown is how long a failed endpoint is demoted for.
+	UnhealthyCooldown time.Duration
+
+	mu        sync.Mutex
+	endpoints []string
+	// unhealthyUntil holds, per endpoint, the time until which it is demoted
+	unhealthyUntil map[string]time.Time
+}
+
+// NewEndpointPool returns an EndpointPool for the supplied endpoint URLs, in
+// order of preference.
+func NewEndpointPool(endpointURLs ...string) (*EndpointPool, error) {
+	if len(endpointURLs) == 0 {
+		return nil, errors.New("Must supply at least one endpoint URL")
+	}
+
+	for _, e := range endpointURLs {
+		if e == "" {
+			return nil, errors.New("Must supply a valid endpoint URL")
+		}
+	}
+
+	return &EndpointPool{
+		UnhealthyCooldown: DefaultUnhealthyCooldown,
+		endpoints:         append([]string{}, endpointURLs...),
+		unhealthyUntil:    make(map[string]time.Time),
+	}, nil
+}
+
+// Primary returns the first configured endpoint.
+func (p *EndpointPool) Primary() string {
+	return p.endpoints[0]
+}
+
+// Endpoints returns all configured endpoints in order of preference.
+func (p *EndpointPool) Endpoints() []string {
+	return append([]string{}, p.endpoints...)
+}
+
+// Healthy reports whether the endpoint has not failed within the cooldown period.
+func (p *EndpointPool) Healthy(endpoint string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.healthy(endpoint, time.Now())
+}
+
+func (p *EndpointPool) healthy(endpoint string, now time.Time) bool {
+	return !now.Before(p.unhealthyUntil[endpoint])
+}
+
+// MarkHealthy clears any recorded failure for the endpoint.
+func (p *EndpointPool) MarkHealthy(endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.unhealthyUntil, endpoint)
+}
+
+// MarkUnhealthy demotes the endpoint for UnhealthyCooldown.
+func (p *EndpointPool) MarkUnhealthy(endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.unhealthyUntil[endpoint] = time.Now().Add(p.UnhealthyCooldown)
+}
+
+// Candidates returns the endpoints to try, in order, for an operation of the
+// given type.  Healthy endpoints come first in configured order, followed by
+// unhealthy ones as a last resort.  Mutations only get the primary unless
+// AllowMutationFailover is set.
+func (p *EndpointPool) Candidates(queryType actions.QueryType) []string {
+	if queryType != actions.QueryTypeQuery && !p.AllowMutationFailover {
+		return []string{p.Primary()}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]string, 0, len(p.endpoints))
+	unhealthy := make([]string, 0)
+	for _, e := range p.endpoints {
+		if p.healthy(e, now) {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
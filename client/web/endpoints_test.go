@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/satcon-client-go/client/actions"
+	. "github.com/IBM/satcon-client-go/client/web"
+)
+
+var _ = Describe("EndpointPool", func() {
+	var (
+		pool *EndpointPool
+		err  error
+	)
+
+	BeforeEach(func() {
+		pool, err = NewEndpointPool("https://primary", "https://secondary", "https://tertiary")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("NewEndpointPool", func() {
+		It("Uses the first endpoint as the primary", func() {
+			Expect(pool.Primary()).To(Equal("https://primary"))
+			Expect(pool.Endpoints()).To(Equal([]string{"https://primary", "https://secondary", "https://tertiary"}))
+			Expect(pool.UnhealthyCooldown).To(Equal(DefaultUnhealthyCooldown))
+		})
+
+		It("Errors when no endpoints are supplied", func() {
+			p, err := NewEndpointPool()
+			Expect(err).To(HaveOccurred())
+			Expect(p).To(BeNil())
+		})
+
+		It("Errors when an endpoint is empty", func() {
+			p, err := NewEndpointPool("https://primary", "")
+			Expect(err).To(HaveOccurred())
+			Expect(p).To(BeNil())
+		})
+	})
+
+	Describe("Candidates", func() {
+		It("Returns all endpoints in order for queries", func() {
+			Expect(pool.Candidates(actions.QueryTypeQuery)).To(Equal([]string{"https://primary", "https://secondary", "https://tertiary"}))
+		})
+
+		It("Returns only the primary for mutations", func() {
+			Expect(pool.Candidates(actions.QueryTypeMutation)).To(Equal([]string{"https://primary"}))
+		})
+
+		Context("When mutation failover is allowed", func() {
+			BeforeEach(func() {
+				pool.AllowMutationFailover = true
+			})
+
+			It("Returns all endpoints for mutations", func() {
+				Expect(pool.Candidates(actions.QueryTypeMutation)).To(HaveLen(3))
+			})
+		})
+
+		Context("When an endpoint is unhealthy", func() {
+			BeforeEach(func() {
+				pool.MarkUnhealthy("https://primary")
+			})
+
+			It("Moves it to the end of the list", func() {
+				Expect(pool.Healthy("https://primary")).To(BeFalse())
+				Expect(pool.Candidates(actions.QueryTypeQuery)).To(Equal([]string{"https://secondary", "https://tertiary", "https://primary"}))
+			})
+
+			It("Restores it once marked healthy", func() {
+				pool.MarkHealthy("https://primary")
+				Expect(pool.Healthy("https://primary")).To(BeTrue())
+				Expect(pool.Candidates(actions.QueryTypeQuery)[0]).To(Equal("https://primary"))
+			})
+		})
+
+		Context("When the cooldown has elapsed", func() {
+			BeforeEach(func() {
+				pool.UnhealthyCooldown = time.Millisecond
+				pool.MarkUnhealthy("https://primary")
+			})
+
+			It("Considers the endpoint healthy again", func() {
+				Eventually(func() bool { return pool.Healthy("https://primary") }).Should(BeTrue())
+			})
+		})
+	})
+})
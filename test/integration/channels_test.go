@@ -8,7 +8,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/IBM/satcon-client-go/client"
-	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/actions/channels"
+	"github.com/IBM/satcon-client-go/client/auth"
 	. "github.com/IBM/satcon-client-go/test/integration"
 )
 
@@ -19,10 +20,10 @@ var _ = Describe("Channels", func() {
 
 	BeforeEach(func() {
 		var err error
-		var iamClient *iam.Client
-		iamClient, err = iam.NewIAMClient(testConfig.APIKey, testConfig.IAMEndpoint)
+		var authClient auth.AuthClient
+		authClient, err = NewAuthClient(testConfig)
 		Expect(err).ToNot(HaveOccurred())
-		c, _ = client.New(testConfig.SatConEndpoint, iamClient.Client)
+		c, _ = client.New(testConfig.SatConEndpoint, authClient)
 		Expect(c.Channels).NotTo(BeNil())
 	})
 
@@ -58,7 +59,7 @@ var _ = Describe("Channels", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found = false
 			for _, channel := range channelList {
-				if channel.UUID == details.UUID {
+				if string(channel.UUID) == details.UUID {
 					found = true
 				}
 			}
@@ -72,6 +73,16 @@ var _ = Describe("Channels", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(channelByName).NotTo(BeNil())
 
+			// Rename the channel in place, then restore its name
+			renamed, err := c.Channels.EditChannel(testConfig.OrgID, details.UUID, channels.EditChannelOptions{Name: channelName + "-renamed"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(renamed.UUID).To(Equal(details.UUID))
+			Expect(renamed.Name).To(Equal(channelName + "-renamed"))
+
+			renamed, err = c.Channels.EditChannel(testConfig.OrgID, details.UUID, channels.EditChannelOptions{Name: channelName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(renamed.Name).To(Equal(channelName))
+
 			rmDetails, err := c.Channels.RemoveChannel(testConfig.OrgID, details.UUID)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(rmDetails.Success).To(BeTrue())
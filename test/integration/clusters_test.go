@@ -7,22 +7,22 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/IBM/satcon-client-go/client"
-	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/auth"
 	"github.com/IBM/satcon-client-go/client/types"
 	. "github.com/IBM/satcon-client-go/test/integration"
 )
 
 var _ = Describe("Clusters", func() {
 	var (
-		c         client.SatCon
-		iamClient *iam.Client
+		c          client.SatCon
+		authClient auth.AuthClient
 	)
 
 	BeforeEach(func() {
 		var err error
-		iamClient, err = iam.NewIAMClient(testConfig.APIKey, testConfig.IAMEndpoint)
+		authClient, err = NewAuthClient(testConfig)
 		Expect(err).ToNot(HaveOccurred())
-		c, _ = client.New(testConfig.SatConEndpoint, iamClient.Client)
+		c, _ = client.New(testConfig.SatConEndpoint, authClient)
 		Expect(c.Clusters).NotTo(BeNil())
 	})
 
@@ -51,7 +51,7 @@ var _ = Describe("Clusters", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found := false
 			for _, cluster := range clusterList {
-				if cluster.ClusterID == details.ClusterID && cluster.Name == clusterName {
+				if string(cluster.ClusterID) == details.ClusterID && cluster.Name == clusterName {
 					found = true
 				}
 			}
@@ -63,6 +63,13 @@ var _ = Describe("Clusters", func() {
 			Expect(cluster.ClusterID).To(Equal(details.ClusterID))
 			Expect(cluster.Name).To(Equal(clusterName))
 
+			cluster, err = c.Clusters.ClusterByClusterID(testConfig.OrgID, details.ClusterID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cluster).NotTo(BeNil())
+			Expect(cluster.Name).To(Equal(clusterName))
+			Expect(cluster.RegistrationState).NotTo(BeEmpty())
+			Expect(cluster.Created).NotTo(BeEmpty())
+
 			delDetails, err := c.Clusters.DeleteClusterByClusterID(testConfig.OrgID, details.ClusterID)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(delDetails.DeletedClusterCount).To(Equal(1))
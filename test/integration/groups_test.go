@@ -10,22 +10,22 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/IBM/satcon-client-go/client"
-	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/auth"
 	. "github.com/IBM/satcon-client-go/test/integration"
 )
 
 var _ = Describe("Groups", func() {
 
 	var (
-		c         client.SatCon
-		iamClient *iam.Client
+		c          client.SatCon
+		authClient auth.AuthClient
 	)
 
 	BeforeEach(func() {
 		var err error
-		iamClient, err = iam.NewIAMClient(testConfig.APIKey, testConfig.IAMEndpoint)
+		authClient, err = NewAuthClient(testConfig)
 		Expect(err).ToNot(HaveOccurred())
-		c, _ = client.New(testConfig.SatConEndpoint, iamClient.Client)
+		c, _ = client.New(testConfig.SatConEndpoint, authClient)
 		Expect(c.Groups).NotTo(BeNil())
 	})
 
@@ -109,7 +109,7 @@ var _ = Describe("Groups", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found = false
 			for _, group := range groups {
-				if strings.Compare(group.UUID, newGroupDetails.UUID) == 0 {
+				if strings.Compare(string(group.UUID), newGroupDetails.UUID) == 0 {
 					found = true
 				}
 			}
@@ -125,7 +125,7 @@ var _ = Describe("Groups", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found = false
 			for _, group := range groups {
-				if strings.Compare(group.UUID, newGroupDetails.UUID) == 0 {
+				if strings.Compare(string(group.UUID), newGroupDetails.UUID) == 0 {
 					found = true
 				}
 			}
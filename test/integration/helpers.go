@@ -7,11 +7,17 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/satcon-client-go/client/auth"
+	"github.com/IBM/satcon-client-go/client/auth/bearer"
+	"github.com/IBM/satcon-client-go/client/auth/iam"
 )
 
 type TestConfig struct {
 	APIKey         string `json:"apiKey,omitempty"`
 	Token          string `json:"token,omitempty"`
+	UseTokenSource bool   `json:"useTokenSource,omitempty"`
 	IAMEndpoint    string `json:"iamEndpoint,omitempty"`
 	SatConEndpoint string `json:"satconEndpoint,omitempty"`
 	OrgID          string `json:"orgId,omitempty"`
@@ -32,6 +38,46 @@ func LoadConfig(configFile string) *TestConfig {
 	return &cfg
 }
 
+// NewAuthClient returns the auth client described by the config: a static bearer
+// token if Token is set, otherwise the IAM API key, either through the IAM client or,
+// if UseTokenSource is set, through an IAM token source.
+func NewAuthClient(cfg *TestConfig) (auth.AuthClient, error) {
+	if cfg.Token != "" {
+		return bearer.NewClient(cfg.Token)
+	}
+
+	if cfg.UseTokenSource {
+		source, err := IAMTokenSource(cfg.APIKey, cfg.IAMEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		return bearer.NewTokenSourceClient(source)
+	}
+
+	return iam.NewIAMClient(cfg.APIKey, cfg.IAMEndpoint)
+}
+
+// IAMTokenSource returns a bearer.TokenSource which exchanges the API key for IAM tokens
+func IAMTokenSource(apiKey, iamEndpoint string) (bearer.TokenSource, error) {
+	authenticator, err := core.NewIamAuthenticator(apiKey, iamEndpoint, "", "", false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return bearer.TokenSourceFunc(func() (*bearer.Token, error) {
+		response, err := authenticator.RequestToken()
+		if err != nil {
+			return nil, err
+		}
+
+		return &bearer.Token{
+			AccessToken: response.AccessToken,
+			TokenType:   response.TokenType,
+			Expiry:      time.Unix(response.Expiration, 0),
+		}, nil
+	}), nil
+}
+
 type IAMTokenResponse struct {
 	Token        string `json:"access_token"`
 	RefreshToken string `json:"refresh_token,omitempty"`
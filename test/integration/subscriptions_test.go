@@ -10,23 +10,23 @@ import (
 	"github.com/IBM/satcon-client-go/client"
 	"github.com/IBM/satcon-client-go/client/actions/channels"
 	"github.com/IBM/satcon-client-go/client/actions/versions"
-	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/auth"
 	"github.com/IBM/satcon-client-go/client/types"
 	. "github.com/IBM/satcon-client-go/test/integration"
 )
 
 var _ = Describe("Subscriptions", func() {
 	var (
-		c         client.SatCon
-		content   []byte
-		iamClient *iam.Client
+		c          client.SatCon
+		content    []byte
+		authClient auth.AuthClient
 	)
 
 	BeforeEach(func() {
 		var err error
-		iamClient, err = iam.NewIAMClient(testConfig.APIKey, testConfig.IAMEndpoint)
+		authClient, err = NewAuthClient(testConfig)
 		Expect(err).ToNot(HaveOccurred())
-		c, _ = client.New(testConfig.SatConEndpoint, iamClient.Client)
+		c, _ = client.New(testConfig.SatConEndpoint, authClient)
 		Expect(c.Subscriptions).NotTo(BeNil())
 
 		encodedContent := "YXBpVmVyc2lvbjogdjEKa2luZDogUG9kCm1ldGFkYXRhOgogIG5hbWU6IGludGVncmF0aW9uX3Rlc3QKc3BlYzoKICBjb250YWluZXJzOgogIC0gbmFtZTogaW50ZWdyYXRpb25fdGVzdAogICAgaW1hZ2U6IGh0dHBkOmFscGluZQo="
@@ -133,7 +133,7 @@ var _ = Describe("Subscriptions", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found := false
 			for _, channel := range channelList {
-				if channel.UUID == channelDetails.UUID {
+				if string(channel.UUID) == channelDetails.UUID {
 					found = true
 				}
 			}
@@ -180,7 +180,7 @@ var _ = Describe("Subscriptions", func() {
 				Expect(err).NotTo(HaveOccurred())
 				found := false
 				for _, subscription := range subscriptionList {
-					if subscription.UUID == subscriptionDetails.UUID {
+					if string(subscription.UUID) == subscriptionDetails.UUID {
 						found = true
 					}
 				}
@@ -203,7 +203,7 @@ var _ = Describe("Subscriptions", func() {
 				Expect(err).NotTo(HaveOccurred())
 				found = false
 				for _, subscription := range subscriptionList {
-					if subscription.UUID == setSubscriptionDetail.UUID && subscription.Version == version2Name {
+					if string(subscription.UUID) == setSubscriptionDetail.UUID && subscription.Version == version2Name {
 						found = true
 					}
 				}
@@ -219,7 +219,7 @@ var _ = Describe("Subscriptions", func() {
 				Expect(err).NotTo(HaveOccurred())
 				found = false
 				for _, subscription := range subscriptionList {
-					if subscription.UUID == subscriptionDetails.UUID {
+					if string(subscription.UUID) == subscriptionDetails.UUID {
 						found = true
 					}
 				}
@@ -267,7 +267,7 @@ var _ = Describe("Subscriptions", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found := false
 			for _, channel := range channelList {
-				if channel.UUID == channelDetails.UUID {
+				if string(channel.UUID) == channelDetails.UUID {
 					found = true
 				}
 			}
@@ -7,20 +7,21 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/IBM/satcon-client-go/client"
-	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/auth"
+	. "github.com/IBM/satcon-client-go/test/integration"
 )
 
 var _ = Describe("Users", func() {
 	var (
-		c         client.SatCon
-		iamClient *iam.Client
+		c          client.SatCon
+		authClient auth.AuthClient
 	)
 
 	BeforeEach(func() {
 		var err error
-		iamClient, err = iam.NewIAMClient(testConfig.APIKey, testConfig.IAMEndpoint)
+		authClient, err = NewAuthClient(testConfig)
 		Expect(err).ToNot(HaveOccurred())
-		c, _ = client.New(testConfig.SatConEndpoint, iamClient.Client)
+		c, _ = client.New(testConfig.SatConEndpoint, authClient)
 		Expect(c.Clusters).NotTo(BeNil())
 	})
 
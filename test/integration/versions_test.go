@@ -8,7 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/IBM/satcon-client-go/client"
-	"github.com/IBM/satcon-client-go/client/auth/iam"
+	"github.com/IBM/satcon-client-go/client/auth"
 	. "github.com/IBM/satcon-client-go/test/integration"
 )
 
@@ -17,14 +17,14 @@ var _ = Describe("Versions", func() {
 		description string
 		c           client.SatCon
 		content     []byte
-		iamClient   *iam.Client
+		authClient  auth.AuthClient
 	)
 
 	BeforeEach(func() {
 		var err error
-		iamClient, err = iam.NewIAMClient(testConfig.APIKey, testConfig.IAMEndpoint)
+		authClient, err = NewAuthClient(testConfig)
 		Expect(err).ToNot(HaveOccurred())
-		c, _ = client.New(testConfig.SatConEndpoint, iamClient.Client)
+		c, _ = client.New(testConfig.SatConEndpoint, authClient)
 		Expect(c.Versions).NotTo(BeNil())
 
 		encodedContent := "YXBpVmVyc2lvbjogdjEKa2luZDogUG9kCm1ldGFkYXRhOgogIG5hbWU6IGludGVncmF0aW9uX3Rlc3QKc3BlYzoKICBjb250YWluZXJzOgogIC0gbmFtZTogaW50ZWdyYXRpb25fdGVzdAogICAgaW1hZ2U6IGh0dHBkOmFscGluZQo="
@@ -65,7 +65,7 @@ var _ = Describe("Versions", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found := false
 			for _, channel := range channelList {
-				if channel.UUID == channelDetails.UUID {
+				if string(channel.UUID) == channelDetails.UUID {
 					found = true
 				}
 			}
@@ -82,7 +82,7 @@ var _ = Describe("Versions", func() {
 			Expect(channelVersionByNameDetails).NotTo(BeNil())
 
 			// Verify the channel version exists (query by UUID)
-			channelVersionDetails, err := c.Versions.ChannelVersion(testConfig.OrgID, channelVersionByNameDetails.ChannelID, channelVersionByNameDetails.UUID)
+			channelVersionDetails, err := c.Versions.ChannelVersion(testConfig.OrgID, string(channelVersionByNameDetails.ChannelID), string(channelVersionByNameDetails.UUID))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(channelVersionDetails).NotTo(BeNil())
 			Expect(channelVersionDetails.Name).To(MatchRegexp(versionName))
@@ -107,7 +107,7 @@ var _ = Describe("Versions", func() {
 			Expect(err).NotTo(HaveOccurred())
 			found = false
 			for _, channel := range channelList {
-				if channel.UUID == channelDetails.UUID {
+				if string(channel.UUID) == channelDetails.UUID {
 					found = true
 				}
 			}
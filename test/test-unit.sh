@@ -4,6 +4,4 @@
 set -e
 
 echo "Running unit tests..."
-# TODO catch dataraces
-# ginkgo -r -p -keepGoing -trace -randomizeAllSpecs -progress --race cli client
-ginkgo -r -p -keep-going -trace -show-node-events cli client
+ginkgo -r -p -keep-going -trace -show-node-events --race cli client